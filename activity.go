@@ -2,27 +2,51 @@ package backlog
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 )
 
+// maxActivityCount is the maximum count of activities in a request
+const maxActivityCount = 100
+
 // Activity : activity
 type Activity struct {
 	ID            *int            `json:"id,omitempty"` // User.ID
 	Project       *Project        `json:"project,omitempty"`
-	Type          *int            `json:"type,omitempty"`
-	Content       *Content        `json:"content,omitempty"`
+	Type          *ActivityType   `json:"type,omitempty"`
+	Content       ActivityContent `json:"content,omitempty"`
 	Notifications []*Notification `json:"notifications,omitempty"`
 	CreatedUser   *User           `json:"createdUser,omitempty"`
 	Created       *Timestamp      `json:"created,omitempty"`
 }
 
+// UnmarshalJSON decodes Content into the type for Type
+func (a *Activity) UnmarshalJSON(b []byte) error {
+	type activity Activity
+	v := struct {
+		*activity
+		Content json.RawMessage `json:"content"`
+	}{activity: (*activity)(a)}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	var k ActivityType
+	if a.Type != nil {
+		k = *a.Type
+	}
+	a.Content = decodeActivityContent(k, v.Content)
+	return nil
+}
+
 // GetUserActivities returns the list of a user's activities
 func (c *Client) GetUserActivities(id int, opts *GetUserActivitiesOptions) ([]*Activity, error) {
 	return c.GetUserActivitiesContext(context.Background(), id, opts)
 }
 
 // GetUserActivitiesContext returns the list of a user's activities with context
-func (c *Client) GetUserActivitiesContext(ctx context.Context, id int, opts *GetUserActivitiesOptions) ([]*Activity, error) {
+func (c *Client) GetUserActivitiesContext(ctx context.Context, id int, opts *GetUserActivitiesOptions, callOpts ...CallOption) ([]*Activity, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/users/%v/activities", id)
 
 	u, err := c.AddOptions(u, opts)
@@ -48,7 +72,8 @@ func (c *Client) GetProjectActivities(projectIDOrKey interface{}, opts *GetProje
 }
 
 // GetProjectActivitiesContext returns the list of a project's activities with context
-func (c *Client) GetProjectActivitiesContext(ctx context.Context, projectIDOrKey interface{}, opts *GetProjectActivitiesOptions) ([]*Activity, error) {
+func (c *Client) GetProjectActivitiesContext(ctx context.Context, projectIDOrKey interface{}, opts *GetProjectActivitiesOptions, callOpts ...CallOption) ([]*Activity, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/activities", projectIDOrKey)
 
 	u, err := c.AddOptions(u, opts)
@@ -68,6 +93,58 @@ func (c *Client) GetProjectActivitiesContext(ctx context.Context, projectIDOrKey
 	return activities, nil
 }
 
+// GetSpaceActivities returns the list of the space's activities
+func (c *Client) GetSpaceActivities(opts *GetSpaceActivitiesOptions) ([]*Activity, error) {
+	return c.GetSpaceActivitiesContext(context.Background(), opts)
+}
+
+// GetSpaceActivitiesContext returns the list of the space's activities with context
+func (c *Client) GetSpaceActivitiesContext(ctx context.Context, opts *GetSpaceActivitiesOptions, callOpts ...CallOption) ([]*Activity, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := "/api/v2/space/activities"
+
+	u, err := c.AddOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var activities []*Activity
+	if err := c.Do(ctx, req, &activities); err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// AllSpaceActivities returns an iterator over the space's activities
+func (c *Client) AllSpaceActivities(opts *GetSpaceActivitiesOptions) iter.Seq2[*Activity, error] {
+	return c.AllSpaceActivitiesContext(context.Background(), opts)
+}
+
+// AllSpaceActivitiesContext returns an iterator over the space's activities with context.
+// It follows the cursor of MinID or MaxID by Order, which is descending by default.
+func (c *Client) AllSpaceActivitiesContext(ctx context.Context, opts *GetSpaceActivitiesOptions, callOpts ...CallOption) iter.Seq2[*Activity, error] {
+	o := GetSpaceActivitiesOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Count == nil {
+		o.Count = Int(maxActivityCount)
+	}
+
+	return cursorSeq(*o.Count, o.Order == OrderAsc, o.MinID, o.MaxID,
+		func(a *Activity) *int { return a.ID },
+		func(minID, maxID *int) ([]*Activity, error) {
+			page := o
+			page.MinID, page.MaxID = minID, maxID
+			return c.GetSpaceActivitiesContext(ctx, &page, callOpts...)
+		})
+}
+
 // GetUserActivitiesOptions specifies parameters to the GetUserActivities method.
 type GetUserActivitiesOptions struct {
 	ActivityTypeIDs []int `url:"activityTypeId[],omitempty"`
@@ -85,3 +162,12 @@ type GetProjectActivitiesOptions struct {
 	Count           *int  `url:"count,omitempty"`
 	Order           Order `url:"order,omitempty"`
 }
+
+// GetSpaceActivitiesOptions specifies parameters to the GetSpaceActivities method.
+type GetSpaceActivitiesOptions struct {
+	ActivityTypeIDs []int `url:"activityTypeId[],omitempty"`
+	MinID           *int  `url:"minId,omitempty"`
+	MaxID           *int  `url:"maxId,omitempty"`
+	Count           *int  `url:"count,omitempty"`
+	Order           Order `url:"order,omitempty"`
+}
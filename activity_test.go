@@ -1,10 +1,12 @@
 package backlog
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -205,3 +207,172 @@ func TestGetProjectActivities_NewRequestError(t *testing.T) {
 
 	client.baseURL = originalBaseURL
 }
+
+func TestGetSpaceActivities(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/space/activities", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query()["activityTypeId[]"]; !reflect.DeepEqual(got, []string{"1", "2"}) {
+			t.Errorf("unexpected activityTypeId[]: %v", got)
+		}
+		j := fmt.Sprintf("[%s]", testJSONActivity)
+		if _, err := fmt.Fprint(w, j); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetSpaceActivities(&GetSpaceActivitiesOptions{ActivityTypeIDs: ActivityTypeIDs(ActivityTypeIssueCreated, ActivityTypeIssueUpdated)})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*Activity{getTestActivity()}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, expected)))
+	}
+}
+
+func TestGetSpaceActivitiesFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/space/activities", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := client.GetSpaceActivities(&GetSpaceActivitiesOptions{}); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestAllSpaceActivities(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// activities 5 to 1 in pages of 2, where maxId is exclusive
+	mux.HandleFunc("/space/activities", func(w http.ResponseWriter, r *http.Request) {
+		maxID := 6
+		if s := r.URL.Query().Get("maxId"); s != "" {
+			maxID, _ = strconv.Atoi(s)
+		}
+		var items []string
+		for id := maxID - 1; id > 0 && id > maxID-3; id-- {
+			items = append(items, fmt.Sprintf(`{"id": %d}`, id))
+		}
+		if _, err := fmt.Fprintf(w, "[%s]", strings.Join(items, ",")); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var ids []int
+	for activity, err := range client.AllSpaceActivities(&GetSpaceActivitiesOptions{Count: Int(2)}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, *activity.ID)
+	}
+	if want := []int{5, 4, 3, 2, 1}; !reflect.DeepEqual(want, ids) {
+		t.Fatalf("want %v, got %v", want, ids)
+	}
+}
+
+func TestActivityContent(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want ActivityContent
+	}{
+		{
+			name: "wiki",
+			json: `{"id": 1, "type": 6, "content": {"id": 2, "name": "Home", "content": "hello", "diff": "+hello"}}`,
+			want: &WikiActivityContent{ID: Int(2), Name: String("Home"), Content: String("hello"), Diff: String("+hello")},
+		},
+		{
+			name: "git push",
+			json: `{"id": 1, "type": 12, "content": {
+				"repository": {"id": 3, "name": "app"},
+				"change_type": "update",
+				"ref": "refs/heads/main",
+				"revision_count": 1,
+				"revisions": [{"rev": "abc123", "comment": "fix"}]
+			}}`,
+			want: &GitPushContent{
+				Repository:    &ActivityRepository{ID: Int(3), Name: String("app")},
+				ChangeType:    String("update"),
+				Ref:           String("refs/heads/main"),
+				RevisionCount: Int(1),
+				Revisions:     []*GitRevision{{Rev: String("abc123"), Comment: String("fix")}},
+			},
+		},
+		{
+			name: "pull request",
+			json: `{"id": 1, "type": 20, "content": {"id": 4, "number": 5, "summary": "feature", "comment": {"id": 6, "content": "LGTM"}}}`,
+			want: &PullRequestContent{ID: Int(4), Number: Int(5), Summary: String("feature"), Comment: &Comment{ID: Int(6), Content: String("LGTM")}},
+		},
+		{
+			name: "project user",
+			json: `{"id": 1, "type": 15, "content": {"users": [{"id": 7}], "comment": ""}}`,
+			want: &ProjectMemberActivityContent{Users: []*User{{ID: Int(7)}}, Comment: String("")},
+		},
+		{
+			name: "project group",
+			json: `{"id": 1, "type": 15, "content": {"group_project_activities": [{"id": 8, "type": 15}]}}`,
+			want: &ProjectMemberActivityContent{GroupProjectActivities: []*GroupProjectActivity{{ID: Int(8), Type: (*ActivityType)(Int(15))}}},
+		},
+		{
+			name: "unknown type",
+			json: `{"id": 1, "type": 99, "content": {"foo":"bar"}}`,
+			want: RawActivityContent(`{"foo":"bar"}`),
+		},
+		{
+			name: "invalid content",
+			json: `{"id": 1, "type": 8, "content": {"id":"x"}}`,
+			want: RawActivityContent(`{"id":"x"}`),
+		},
+		{
+			name: "no content",
+			json: `{"id": 1, "type": 1}`,
+			want: nil,
+		},
+		{
+			name: "no type",
+			json: `{"id": 1, "content": {"foo":"bar"}}`,
+			want: RawActivityContent(`{"foo":"bar"}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var activity Activity
+			if err := json.Unmarshal([]byte(tt.json), &activity); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tt.want, activity.Content) {
+				t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(tt.want, activity.Content)))
+			}
+
+			// the content is encoded back as it is
+			b, err := json.Marshal(&activity)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var again Activity
+			if err := json.Unmarshal(b, &again); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(activity.Content, again.Content) {
+				t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(activity.Content, again.Content)))
+			}
+		})
+	}
+}
+
+func TestActivityTypeString(t *testing.T) {
+	if got := ActivityTypeGitPushed.String(); got != "git pushed" {
+		t.Errorf("unexpected name: %s", got)
+	}
+	if got := ActivityType(99).String(); got != "ActivityType(99)" {
+		t.Errorf("unexpected name: %s", got)
+	}
+}
@@ -0,0 +1,304 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ActivityType : the type of an activity, which is also used to choose the
+// activities notified by webhooks.
+// ActivityTypeIDs converts the types for the options of activities,
+// e.g. ActivityTypeIDs: ActivityTypeIDs(ActivityTypeIssueCreated).
+type ActivityType int
+
+// ActivityType
+const (
+	ActivityTypeIssueCreated              = ActivityType(1)
+	ActivityTypeIssueUpdated              = ActivityType(2)
+	ActivityTypeIssueCommented            = ActivityType(3)
+	ActivityTypeIssueDeleted              = ActivityType(4)
+	ActivityTypeWikiCreated               = ActivityType(5)
+	ActivityTypeWikiUpdated               = ActivityType(6)
+	ActivityTypeWikiDeleted               = ActivityType(7)
+	ActivityTypeFileAdded                 = ActivityType(8)
+	ActivityTypeFileUpdated               = ActivityType(9)
+	ActivityTypeFileDeleted               = ActivityType(10)
+	ActivityTypeSVNCommitted              = ActivityType(11)
+	ActivityTypeGitPushed                 = ActivityType(12)
+	ActivityTypeGitRepositoryCreated      = ActivityType(13)
+	ActivityTypeIssueMultiUpdated         = ActivityType(14)
+	ActivityTypeProjectUserAdded          = ActivityType(15)
+	ActivityTypeProjectUserRemoved        = ActivityType(16)
+	ActivityTypeCommentNotificationAdded  = ActivityType(17)
+	ActivityTypePullRequestAdded          = ActivityType(18)
+	ActivityTypePullRequestUpdated        = ActivityType(19)
+	ActivityTypeCommentAddedOnPullRequest = ActivityType(20)
+	ActivityTypePullRequestDeleted        = ActivityType(21)
+	ActivityTypeMilestoneCreated          = ActivityType(22)
+	ActivityTypeMilestoneUpdated          = ActivityType(23)
+	ActivityTypeMilestoneDeleted          = ActivityType(24)
+	ActivityTypeProjectGroupAdded         = ActivityType(25)
+	ActivityTypeProjectGroupRemoved       = ActivityType(26)
+)
+
+var activityTypeNames = map[ActivityType]string{
+	ActivityTypeIssueCreated:              "issue created",
+	ActivityTypeIssueUpdated:              "issue updated",
+	ActivityTypeIssueCommented:            "issue commented",
+	ActivityTypeIssueDeleted:              "issue deleted",
+	ActivityTypeWikiCreated:               "wiki created",
+	ActivityTypeWikiUpdated:               "wiki updated",
+	ActivityTypeWikiDeleted:               "wiki deleted",
+	ActivityTypeFileAdded:                 "file added",
+	ActivityTypeFileUpdated:               "file updated",
+	ActivityTypeFileDeleted:               "file deleted",
+	ActivityTypeSVNCommitted:              "svn committed",
+	ActivityTypeGitPushed:                 "git pushed",
+	ActivityTypeGitRepositoryCreated:      "git repository created",
+	ActivityTypeIssueMultiUpdated:         "issue multi updated",
+	ActivityTypeProjectUserAdded:          "project user added",
+	ActivityTypeProjectUserRemoved:        "project user removed",
+	ActivityTypeCommentNotificationAdded:  "comment notification added",
+	ActivityTypePullRequestAdded:          "pull request added",
+	ActivityTypePullRequestUpdated:        "pull request updated",
+	ActivityTypeCommentAddedOnPullRequest: "comment added on pull request",
+	ActivityTypePullRequestDeleted:        "pull request deleted",
+	ActivityTypeMilestoneCreated:          "milestone created",
+	ActivityTypeMilestoneUpdated:          "milestone updated",
+	ActivityTypeMilestoneDeleted:          "milestone deleted",
+	ActivityTypeProjectGroupAdded:         "project group added",
+	ActivityTypeProjectGroupRemoved:       "project group removed",
+}
+
+func (k ActivityType) String() string {
+	if name, ok := activityTypeNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("ActivityType(%d)", int(k))
+}
+
+// ActivityTypeIDs returns the types as ints
+func ActivityTypeIDs(types ...ActivityType) []int {
+	return intIDs(types)
+}
+
+// ActivityContent : the content of an activity, whose type depends on the type of the activity.
+//
+//   - *IssueActivityContent for issue activities and comment notifications
+//   - *IssueMultiUpdateContent for issue multi updates
+//   - *WikiActivityContent for wiki activities
+//   - *FileActivityContent for shared file activities
+//   - *SVNCommitContent for svn commits
+//   - *GitPushContent for git pushes
+//   - *GitRepositoryContent for git repository creations
+//   - *ProjectMemberActivityContent for project user and group activities
+//   - *PullRequestContent for pull request activities
+//   - *MilestoneActivityContent for milestone activities
+//   - RawActivityContent for unknown types or content which cannot be decoded
+type ActivityContent interface {
+	activityContent()
+}
+
+// IssueActivityContent : the content of issue activities
+type IssueActivityContent struct {
+	ID          *int                  `json:"id,omitempty"`
+	KeyID       *int                  `json:"key_id,omitempty"`
+	Summary     *string               `json:"summary,omitempty"`
+	Description *string               `json:"description,omitempty"`
+	Comment     *Comment              `json:"comment,omitempty"`
+	Changes     []*Change             `json:"changes,omitempty"`
+	Attachments []*ActivityAttachment `json:"attachments,omitempty"`
+	SharedFiles []*ActivitySharedFile `json:"shared_files,omitempty"`
+}
+
+// Content : the content of issue activities
+//
+// Deprecated: use IssueActivityContent
+type Content = IssueActivityContent
+
+// IssueMultiUpdateContent : the content of issue multi updates
+type IssueMultiUpdateContent struct {
+	TxID    *int                 `json:"tx_id,omitempty"`
+	Comment *Comment             `json:"comment,omitempty"`
+	Link    []*IssueActivityLink `json:"link,omitempty"`
+	Changes []*Change            `json:"changes,omitempty"`
+}
+
+// IssueActivityLink : an issue updated by an issue multi update
+type IssueActivityLink struct {
+	ID      *int     `json:"id,omitempty"`
+	KeyID   *int     `json:"key_id,omitempty"`
+	Title   *string  `json:"title,omitempty"`
+	Comment *Comment `json:"comment,omitempty"`
+}
+
+// WikiActivityContent : the content of wiki activities
+type WikiActivityContent struct {
+	ID          *int                  `json:"id,omitempty"`
+	Name        *string               `json:"name,omitempty"`
+	Content     *string               `json:"content,omitempty"`
+	Diff        *string               `json:"diff,omitempty"`
+	Version     *int                  `json:"version,omitempty"`
+	Attachments []*ActivityAttachment `json:"attachments,omitempty"`
+	SharedFiles []*ActivitySharedFile `json:"shared_files,omitempty"`
+}
+
+// FileActivityContent : the content of shared file activities
+type FileActivityContent struct {
+	ID   *int    `json:"id,omitempty"`
+	Dir  *string `json:"dir,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Size *int    `json:"size,omitempty"`
+}
+
+// SVNCommitContent : the content of svn commits
+type SVNCommitContent struct {
+	Rev     *int    `json:"rev,omitempty"`
+	Comment *string `json:"comment,omitempty"`
+}
+
+// GitPushContent : the content of git pushes
+type GitPushContent struct {
+	Repository    *ActivityRepository `json:"repository,omitempty"`
+	ChangeType    *string             `json:"change_type,omitempty"`
+	RevisionType  *string             `json:"revision_type,omitempty"`
+	Ref           *string             `json:"ref,omitempty"`
+	RevisionCount *int                `json:"revision_count,omitempty"`
+	Revisions     []*GitRevision      `json:"revisions,omitempty"`
+}
+
+// GitRevision : a revision pushed to a git repository
+type GitRevision struct {
+	Rev     *string `json:"rev,omitempty"`
+	Comment *string `json:"comment,omitempty"`
+}
+
+// GitRepositoryContent : the content of git repository creations
+type GitRepositoryContent struct {
+	Repository *ActivityRepository `json:"repository,omitempty"`
+}
+
+// ProjectMemberActivityContent : the content of project user and group activities
+type ProjectMemberActivityContent struct {
+	Users                  []*User                 `json:"users,omitempty"`
+	Groups                 []*Team                 `json:"groups,omitempty"`
+	GroupProjectActivities []*GroupProjectActivity `json:"group_project_activities,omitempty"`
+	Comment                *string                 `json:"comment,omitempty"`
+}
+
+// GroupProjectActivity : an activity of a group related to a project member activity
+type GroupProjectActivity struct {
+	ID   *int          `json:"id,omitempty"`
+	Type *ActivityType `json:"type,omitempty"`
+}
+
+// PullRequestContent : the content of pull request activities
+type PullRequestContent struct {
+	ID          *int                `json:"id,omitempty"`
+	Number      *int                `json:"number,omitempty"`
+	Summary     *string             `json:"summary,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Comment     *Comment            `json:"comment,omitempty"`
+	Changes     []*Change           `json:"changes,omitempty"`
+	Repository  *ActivityRepository `json:"repository,omitempty"`
+	Issue       *IssueActivityLink  `json:"issue,omitempty"`
+}
+
+// MilestoneActivityContent : the content of milestone activities
+type MilestoneActivityContent struct {
+	ID            *int      `json:"id,omitempty"`
+	Name          *string   `json:"name,omitempty"`
+	Description   *string   `json:"description,omitempty"`
+	StartDate     *string   `json:"start_date,omitempty"`
+	ReferenceDate *string   `json:"reference_date,omitempty"`
+	Changes       []*Change `json:"changes,omitempty"`
+}
+
+// RawActivityContent : the content of an activity as it is
+type RawActivityContent json.RawMessage
+
+// MarshalJSON returns the content as it is
+func (r RawActivityContent) MarshalJSON() ([]byte, error) {
+	if len(r) == 0 {
+		return []byte("null"), nil
+	}
+	return r, nil
+}
+
+// ActivityRepository : a git repository in an activity
+type ActivityRepository struct {
+	ID          *int    `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ActivityAttachment : an attachment in an activity
+type ActivityAttachment struct {
+	ID   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Size *int    `json:"size,omitempty"`
+}
+
+// ActivitySharedFile : a shared file in an activity
+type ActivitySharedFile struct {
+	ID   *int    `json:"id,omitempty"`
+	Dir  *string `json:"dir,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Size *int    `json:"size,omitempty"`
+}
+
+func (*IssueActivityContent) activityContent()         {}
+func (*IssueMultiUpdateContent) activityContent()      {}
+func (*WikiActivityContent) activityContent()          {}
+func (*FileActivityContent) activityContent()          {}
+func (*SVNCommitContent) activityContent()             {}
+func (*GitPushContent) activityContent()               {}
+func (*GitRepositoryContent) activityContent()         {}
+func (*ProjectMemberActivityContent) activityContent() {}
+func (*PullRequestContent) activityContent()           {}
+func (*MilestoneActivityContent) activityContent()     {}
+func (RawActivityContent) activityContent()            {}
+
+// newActivityContent returns the content to decode for an activity type
+func newActivityContent(k ActivityType) ActivityContent {
+	switch k {
+	case ActivityTypeIssueCreated, ActivityTypeIssueUpdated, ActivityTypeIssueCommented,
+		ActivityTypeIssueDeleted, ActivityTypeCommentNotificationAdded:
+		return &IssueActivityContent{}
+	case ActivityTypeIssueMultiUpdated:
+		return &IssueMultiUpdateContent{}
+	case ActivityTypeWikiCreated, ActivityTypeWikiUpdated, ActivityTypeWikiDeleted:
+		return &WikiActivityContent{}
+	case ActivityTypeFileAdded, ActivityTypeFileUpdated, ActivityTypeFileDeleted:
+		return &FileActivityContent{}
+	case ActivityTypeSVNCommitted:
+		return &SVNCommitContent{}
+	case ActivityTypeGitPushed:
+		return &GitPushContent{}
+	case ActivityTypeGitRepositoryCreated:
+		return &GitRepositoryContent{}
+	case ActivityTypeProjectUserAdded, ActivityTypeProjectUserRemoved,
+		ActivityTypeProjectGroupAdded, ActivityTypeProjectGroupRemoved:
+		return &ProjectMemberActivityContent{}
+	case ActivityTypePullRequestAdded, ActivityTypePullRequestUpdated,
+		ActivityTypeCommentAddedOnPullRequest, ActivityTypePullRequestDeleted:
+		return &PullRequestContent{}
+	case ActivityTypeMilestoneCreated, ActivityTypeMilestoneUpdated, ActivityTypeMilestoneDeleted:
+		return &MilestoneActivityContent{}
+	default:
+		return nil
+	}
+}
+
+// decodeActivityContent decodes the content of an activity by its type.
+// It falls back on RawActivityContent rather than failing the whole response.
+func decodeActivityContent(k ActivityType, data json.RawMessage) ActivityContent {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+	content := newActivityContent(k)
+	if content == nil || json.Unmarshal(data, content) != nil {
+		return RawActivityContent(data)
+	}
+	return content
+}
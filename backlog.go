@@ -14,6 +14,8 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/pkg/errors"
@@ -32,6 +34,24 @@ type Client struct {
 	debug      bool
 	log        ilogger
 	httpclient httpClient
+
+	idempotencyFieldID int
+	idempotencyStore   IdempotencyStore
+	idempotencyLocks   *keyedMutex
+
+	dryRun   bool
+	readOnly bool
+	dryRunMu sync.Mutex
+	dryRuns  []*DryRunRequest
+	// placeholder IDs of dry-run mode, and those given to issues keyed by issue key
+	dryRunSeq int
+	dryRunIDs map[string]int
+
+	journal *Journal
+
+	coalescer *coalescer
+
+	retry RetryPolicy
 }
 
 // Option defines an option for a Client
@@ -58,6 +78,65 @@ func OptionLog(l logger) func(*Client) {
 	}
 }
 
+// OptionIdempotencyCustomField records the external IDs of CreateIssueIdempotent
+// in the custom field instead of a marker in the description.
+func OptionIdempotencyCustomField(customFieldID int) func(*Client) {
+	return func(c *Client) {
+		c.idempotencyFieldID = customFieldID
+	}
+}
+
+// OptionIdempotencyStore sets the store of the issues created by CreateIssueIdempotent.
+// The default store is in memory.
+func OptionIdempotencyStore(store IdempotencyStore) func(*Client) {
+	return func(c *Client) {
+		c.idempotencyStore = store
+	}
+}
+
+// OptionDryRun sends GET requests as usual but intercepts mutating requests.
+// Intercepted requests are logged and recorded, and the request payloads are
+// decoded into the results in place of responses. Created resources get
+// negative placeholder IDs, and created issues get keys such as "DRYRUN-1".
+func OptionDryRun(b bool) func(*Client) {
+	return func(c *Client) {
+		c.dryRun = b
+	}
+}
+
+// OptionReadOnly fails mutating requests with ReadOnlyError
+func OptionReadOnly(b bool) func(*Client) {
+	return func(c *Client) {
+		c.readOnly = b
+	}
+}
+
+// OptionJournal records the mutating requests with the previous states of
+// the resources to the journal.
+func OptionJournal(j *Journal) func(*Client) {
+	return func(c *Client) {
+		c.journal = j
+	}
+}
+
+// OptionRequestCoalescing shares a response among concurrent identical GET
+// requests, so that only one of them is sent.
+func OptionRequestCoalescing(b bool) func(*Client) {
+	return func(c *Client) {
+		c.coalescer = nil
+		if b {
+			c.coalescer = newCoalescer()
+		}
+	}
+}
+
+// OptionRetryPolicy sets the retry policy of GET requests. Requests are not retried by default.
+func OptionRetryPolicy(p RetryPolicy) func(*Client) {
+	return func(c *Client) {
+		c.retry = p
+	}
+}
+
 // New builds a backlog client from the provided token, baseURL and options
 func New(apiKey, endpoint string, options ...Option) *Client {
 	baseURL, _ := url.Parse(endpoint)
@@ -67,6 +146,9 @@ func New(apiKey, endpoint string, options ...Option) *Client {
 		baseURL:    baseURL,
 		httpclient: &http.Client{},
 		log:        log.New(os.Stderr, "kenzo0107/backlog", log.LstdFlags|log.Lshortfile),
+
+		idempotencyStore: NewMemoryIdempotencyStore(),
+		idempotencyLocks: newKeyedMutex(),
 	}
 
 	for _, opt := range options {
@@ -99,6 +181,16 @@ func (c *Client) Debug() bool {
 	return c.debug
 }
 
+// DryRun returns if dry-run is enabled.
+func (c *Client) DryRun() bool {
+	return c.dryRun
+}
+
+// ReadOnly returns if read-only is enabled.
+func (c *Client) ReadOnly() bool {
+	return c.readOnly
+}
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool { return &v }
@@ -115,6 +207,10 @@ func String(v string) *string { return &v }
 // to store v and returns a pointer to it.
 func Int64(v int64) *int64 { return &v }
 
+// Float64 is a helper routine that allocates a new float64 value
+// to store v and returns a pointer to it.
+func Float64(v float64) *float64 { return &v }
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
@@ -231,6 +327,10 @@ func (c *Client) UploadMultipartFile(ctx context.Context, method, urlStr, fpath,
 // first decode it. If rate limit is exceeded and reset time is in the future,
 // Do returns *RateLimitError immediately without making a network API call.
 //
+// Requests other than GET are not sent with OptionReadOnly or OptionDryRun;
+// Do returns *ReadOnlyError, or records the request in dry-run mode.
+// The CallOptions carried by ctx apply to the request.
+//
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is canceled or times out,
 // ctx.Err() will be returned.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error {
@@ -238,9 +338,50 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error
 		return errors.New("context must be non-nil")
 	}
 
+	o := callOptionsFrom(ctx)
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+	for k, vs := range o.header {
+		for _, value := range vs {
+			req.Header.Add(k, value)
+		}
+	}
+
+	if !isSafeMethod(req.Method) {
+		dryRun := c.dryRun
+		if o.dryRun != nil {
+			dryRun = *o.dryRun
+		}
+		if c.readOnly {
+			return c.refuseRequest(req)
+		}
+		if o.noNotify {
+			if err := suppressNotification(req); err != nil {
+				return err
+			}
+		}
+		if dryRun {
+			return c.interceptRequest(req, v)
+		}
+		if c.journal != nil {
+			return c.doJournaled(ctx, req, v)
+		}
+	}
+	// requests with their own headers or capturing responses are not shared
+	if req.Method == http.MethodGet && c.coalescer != nil && !o.noCoalesce && o.header == nil && o.response == nil {
+		return c.doCoalesced(ctx, req, v)
+	}
+	return c.do(ctx, req, v)
+}
+
+// do sends an API request without the interception of Do
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) error {
 	req = req.WithContext(ctx)
 
-	resp, err := c.httpclient.Do(req)
+	resp, err := c.send(ctx, req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -258,6 +399,11 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error
 		}
 	}()
 
+	if r := callOptionsFrom(ctx).response; r != nil {
+		r.StatusCode = resp.StatusCode
+		r.Header = resp.Header
+	}
+
 	err = checkStatusCode(resp, c)
 	if err != nil {
 		return err
@@ -282,6 +428,41 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error
 	return err
 }
 
+// send sends a request, retrying GET requests by the retry policy
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	policy := c.retry
+	if o := callOptionsFrom(ctx); o.retry != nil {
+		policy = *o.retry
+	}
+	if req.Method != http.MethodGet {
+		policy.MaxRetries = 0
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.httpclient.Do(req)
+		if attempt >= policy.MaxRetries || ctx.Err() != nil {
+			return resp, err
+		}
+		if err == nil {
+			if !isRetryableStatus(resp.StatusCode) {
+				return resp, nil
+			}
+			if er := resp.Body.Close(); er != nil {
+				return nil, er
+			}
+		}
+
+		c.Debugf("retrying %s %s (%d/%d)", req.Method, req.URL.Path, attempt+1, policy.MaxRetries)
+		timer := time.NewTimer(policy.Backoff << attempt)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
 // AddOptions adds the parameters in opt as URL query parameters to s. opt
 // must be a struct whose fields may contain "url" tags.
 func (c *Client) AddOptions(s string, opts interface{}) (string, error) {
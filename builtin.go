@@ -0,0 +1,201 @@
+package backlog
+
+import "fmt"
+
+// PriorityID : the ID of a priority.
+// Ptr and PriorityIDs convert the IDs for the fields of options and inputs,
+// e.g. PriorityID: PriorityHigh.Ptr() and PriorityIDs: PriorityIDs(PriorityHigh).
+type PriorityID int
+
+// PriorityID of the built-in priorities
+const (
+	PriorityHigh   = PriorityID(2)
+	PriorityNormal = PriorityID(3)
+	PriorityLow    = PriorityID(4)
+)
+
+func (k PriorityID) String() string {
+	return builtinName(k, "PriorityID", priorityNames, 0)
+}
+
+// Japanese returns the Japanese name of the priority
+func (k PriorityID) Japanese() string {
+	return builtinName(k, "PriorityID", priorityNames, 1)
+}
+
+// Ptr returns a pointer to the ID as int
+func (k PriorityID) Ptr() *int {
+	return Int(int(k))
+}
+
+var priorityNames = map[PriorityID][2]string{
+	PriorityHigh:   {"high", "高"},
+	PriorityNormal: {"normal", "中"},
+	PriorityLow:    {"low", "低"},
+}
+
+// StatusID : the ID of an issue status.
+// Statuses added to projects have their own IDs.
+type StatusID int
+
+// StatusID of the default statuses
+const (
+	StatusOpen       = StatusID(1)
+	StatusInProgress = StatusID(2)
+	StatusResolved   = StatusID(3)
+	StatusClosed     = StatusID(4)
+)
+
+func (k StatusID) String() string {
+	return builtinName(k, "StatusID", statusNames, 0)
+}
+
+// Japanese returns the Japanese name of the status
+func (k StatusID) Japanese() string {
+	return builtinName(k, "StatusID", statusNames, 1)
+}
+
+// Ptr returns a pointer to the ID as int
+func (k StatusID) Ptr() *int {
+	return Int(int(k))
+}
+
+var statusNames = map[StatusID][2]string{
+	StatusOpen:       {"open", "未対応"},
+	StatusInProgress: {"in progress", "処理中"},
+	StatusResolved:   {"resolved", "処理済み"},
+	StatusClosed:     {"closed", "完了"},
+}
+
+// ResolutionID : the ID of a resolution
+type ResolutionID int
+
+// ResolutionID of the built-in resolutions
+const (
+	ResolutionFixed           = ResolutionID(0)
+	ResolutionWontFix         = ResolutionID(1)
+	ResolutionInvalid         = ResolutionID(2)
+	ResolutionDuplicate       = ResolutionID(3)
+	ResolutionCannotReproduce = ResolutionID(4)
+)
+
+func (k ResolutionID) String() string {
+	return builtinName(k, "ResolutionID", resolutionNames, 0)
+}
+
+// Japanese returns the Japanese name of the resolution
+func (k ResolutionID) Japanese() string {
+	return builtinName(k, "ResolutionID", resolutionNames, 1)
+}
+
+// Ptr returns a pointer to the ID as int
+func (k ResolutionID) Ptr() *int {
+	return Int(int(k))
+}
+
+var resolutionNames = map[ResolutionID][2]string{
+	ResolutionFixed:           {"fixed", "対応済み"},
+	ResolutionWontFix:         {"won't fix", "対応しない"},
+	ResolutionInvalid:         {"invalid", "無効"},
+	ResolutionDuplicate:       {"duplicate", "重複"},
+	ResolutionCannotReproduce: {"cannot reproduce", "再現しない"},
+}
+
+// PullRequestStatusID : the ID of a pull request status
+type PullRequestStatusID int
+
+// PullRequestStatusID
+const (
+	PullRequestStatusOpen   = PullRequestStatusID(1)
+	PullRequestStatusClosed = PullRequestStatusID(2)
+	PullRequestStatusMerged = PullRequestStatusID(3)
+)
+
+func (k PullRequestStatusID) String() string {
+	return builtinName(k, "PullRequestStatusID", pullRequestStatusNames, 0)
+}
+
+// Japanese returns the Japanese name of the pull request status
+func (k PullRequestStatusID) Japanese() string {
+	return builtinName(k, "PullRequestStatusID", pullRequestStatusNames, 1)
+}
+
+// Ptr returns a pointer to the ID as int
+func (k PullRequestStatusID) Ptr() *int {
+	return Int(int(k))
+}
+
+var pullRequestStatusNames = map[PullRequestStatusID][2]string{
+	PullRequestStatusOpen:   {"open", "オープン"},
+	PullRequestStatusClosed: {"closed", "クローズ"},
+	PullRequestStatusMerged: {"merged", "マージ済み"},
+}
+
+// builtinName returns the name of k in English (lang 0) or Japanese (lang 1)
+func builtinName[K ~int](k K, typeName string, names map[K][2]string, lang int) string {
+	if name, ok := names[k]; ok {
+		return name[lang]
+	}
+	return fmt.Sprintf("%s(%d)", typeName, int(k))
+}
+
+// PriorityIDs returns the IDs as ints
+func PriorityIDs(ids ...PriorityID) []int {
+	return intIDs(ids)
+}
+
+// StatusIDs returns the IDs as ints
+func StatusIDs(ids ...StatusID) []int {
+	return intIDs(ids)
+}
+
+// ResolutionIDs returns the IDs as ints
+func ResolutionIDs(ids ...ResolutionID) []int {
+	return intIDs(ids)
+}
+
+// PullRequestStatusIDs returns the IDs as ints
+func PullRequestStatusIDs(ids ...PullRequestStatusID) []int {
+	return intIDs(ids)
+}
+
+func intIDs[K ~int](ids []K) []int {
+	ints := make([]int, len(ids))
+	for i, id := range ids {
+		ints[i] = int(id)
+	}
+	return ints
+}
+
+// PriorityType returns the ID of Priority as PriorityID
+func (i *Issue) PriorityType() PriorityID {
+	if i == nil || i.Priority == nil || i.Priority.ID == nil {
+		return 0
+	}
+	return PriorityID(*i.Priority.ID)
+}
+
+// StatusType returns the ID of Status as StatusID
+func (i *Issue) StatusType() StatusID {
+	if i == nil || i.Status == nil || i.Status.ID == nil {
+		return 0
+	}
+	return StatusID(*i.Status.ID)
+}
+
+// ResolutionType returns the ID of Resolution as ResolutionID.
+// ok is false when the issue has no resolution, since ResolutionFixed is 0.
+func (i *Issue) ResolutionType() (id ResolutionID, ok bool) {
+	if i == nil || i.Resolution == nil || i.Resolution.ID == nil {
+		return 0, false
+	}
+	return ResolutionID(*i.Resolution.ID), true
+}
+
+// StatusType returns the ID of Status as PullRequestStatusID
+func (pr *PullRequest) StatusType() PullRequestStatusID {
+	if pr == nil || pr.Status == nil || pr.Status.ID == nil {
+		return 0
+	}
+	return PullRequestStatusID(*pr.Status.ID)
+}
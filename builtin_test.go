@@ -0,0 +1,101 @@
+package backlog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltinNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface {
+			String() string
+			Japanese() string
+		}
+		english  string
+		japanese string
+	}{
+		{"priority", PriorityHigh, "high", "高"},
+		{"priority unknown", PriorityID(9), "PriorityID(9)", "PriorityID(9)"},
+		{"status", StatusInProgress, "in progress", "処理中"},
+		{"status custom", StatusID(100), "StatusID(100)", "StatusID(100)"},
+		{"resolution", ResolutionFixed, "fixed", "対応済み"},
+		{"resolution", ResolutionCannotReproduce, "cannot reproduce", "再現しない"},
+		{"pull request status", PullRequestStatusMerged, "merged", "マージ済み"},
+		{"notification reason", NotificationReasonFileAdded, "file added", "ファイルを追加"},
+		{"notification reason unknown", NotificationReason(99), "NotificationReason(99)", "NotificationReason(99)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.english, tt.value.String())
+			assert.Equal(t, tt.japanese, tt.value.Japanese())
+		})
+	}
+}
+
+func TestBuiltinIDsQuery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, []string{"1", "2"}, q["statusId[]"])
+		assert.Equal(t, []string{"2"}, q["priorityId[]"])
+		assert.Equal(t, []string{"0"}, q["resolutionId[]"])
+		w.Write([]byte(`[]`))
+	})
+
+	_, err := client.GetIssuesContext(context.Background(), &GetIssuesOptions{
+		StatusIDs:     StatusIDs(StatusOpen, StatusInProgress),
+		PriorityIDs:   PriorityIDs(PriorityHigh),
+		ResolutionIDs: ResolutionIDs(ResolutionFixed),
+	})
+	assert.NoError(t, err)
+}
+
+func TestBuiltinIDsInput(t *testing.T) {
+	b, err := json.Marshal(&UpdateIssueInput{
+		StatusID:   StatusResolved.Ptr(),
+		PriorityID: PriorityLow.Ptr(),
+	})
+	assert.NoError(t, err)
+
+	var fields map[string]interface{}
+	assert.NoError(t, json.Unmarshal(b, &fields))
+	assert.Equal(t, float64(3), fields["statusId"])
+	assert.Equal(t, float64(4), fields["priorityId"])
+}
+
+func TestIssue_BuiltinTypes(t *testing.T) {
+	issue := &Issue{
+		Priority:   &Priority{ID: Int(2)},
+		Status:     &Status{ID: Int(4)},
+		Resolution: &Resolution{ID: Int(0)},
+	}
+	assert.Equal(t, PriorityHigh, issue.PriorityType())
+	assert.Equal(t, StatusClosed, issue.StatusType())
+	resolution, ok := issue.ResolutionType()
+	assert.True(t, ok)
+	assert.Equal(t, ResolutionFixed, resolution)
+
+	_, ok = (&Issue{}).ResolutionType()
+	assert.False(t, ok)
+	assert.Equal(t, StatusID(0), (*Issue)(nil).StatusType())
+}
+
+func TestBuiltinIDHelpers(t *testing.T) {
+	assert.Equal(t, 4, *StatusClosed.Ptr())
+	assert.Equal(t, 0, *ResolutionFixed.Ptr())
+	assert.Equal(t, []int{1, 3}, PullRequestStatusIDs(PullRequestStatusOpen, PullRequestStatusMerged))
+	assert.Empty(t, StatusIDs())
+}
+
+func TestPullRequest_StatusType(t *testing.T) {
+	pr := &PullRequest{Status: &Status{ID: Int(3)}}
+	assert.Equal(t, PullRequestStatusMerged, pr.StatusType())
+	assert.Equal(t, PullRequestStatusID(0), (&PullRequest{}).StatusType())
+}
@@ -0,0 +1,163 @@
+package backlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// CallOption : an option for a single call of a ...Context method.
+// Options are carried by the context, so they apply to all the requests
+// a call sends.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	timeout    time.Duration
+	retry      *RetryPolicy
+	header     http.Header
+	response   *CallResponse
+	noCoalesce bool
+	dryRun     *bool
+	noNotify   bool
+}
+
+// CallResponse : the status and the header of the response captured by WithResponse
+type CallResponse struct {
+	StatusCode int
+	Header     http.Header
+}
+
+// RetryPolicy specifies how GET requests are retried on network errors and
+// on the responses of 429 Too Many Requests and 5xx errors.
+// The wait before the n-th retry is Backoff * 2^(n-1).
+type RetryPolicy struct {
+	MaxRetries int
+	Backoff    time.Duration
+}
+
+// WithTimeout sets the timeout of each request of the call
+func WithTimeout(d time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = d
+	}
+}
+
+// WithRetryPolicy overrides the retry policy of the client set by OptionRetryPolicy
+func WithRetryPolicy(p RetryPolicy) CallOption {
+	return func(o *callOptions) {
+		o.retry = &p
+	}
+}
+
+// WithoutRetry disables retries
+func WithoutRetry() CallOption {
+	return WithRetryPolicy(RetryPolicy{})
+}
+
+// WithHeader adds a header to the requests of the call
+func WithHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		if o.header == nil {
+			o.header = http.Header{}
+		}
+		o.header.Add(key, value)
+	}
+}
+
+// WithResponse captures the status and the header of the last response of the call
+func WithResponse(r *CallResponse) CallOption {
+	return func(o *callOptions) {
+		o.response = r
+	}
+}
+
+// WithoutCoalescing sends the GET requests of the call even if identical
+// requests are in flight with OptionRequestCoalescing
+func WithoutCoalescing() CallOption {
+	return func(o *callOptions) {
+		o.noCoalesce = true
+	}
+}
+
+// WithDryRun overrides OptionDryRun for the call
+func WithDryRun(b bool) CallOption {
+	return func(o *callOptions) {
+		o.dryRun = &b
+	}
+}
+
+// WithoutNotification removes the users to notify from the request bodies of
+// the call, and turns off mail notifications of wikis
+func WithoutNotification() CallOption {
+	return func(o *callOptions) {
+		o.noNotify = true
+	}
+}
+
+type callOptionsKey struct{}
+
+// WithCallOptions returns a copy of ctx carrying opts in addition to the
+// options ctx already carries. It is useful to apply options to Do directly.
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	return withCallOptions(ctx, opts)
+}
+
+func withCallOptions(ctx context.Context, opts []CallOption) context.Context {
+	if ctx == nil || len(opts) == 0 {
+		return ctx
+	}
+	o := callOptionsFrom(ctx)
+	if o.header != nil {
+		o.header = o.header.Clone()
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return context.WithValue(ctx, callOptionsKey{}, o)
+}
+
+func callOptionsFrom(ctx context.Context) callOptions {
+	if o, ok := ctx.Value(callOptionsKey{}).(callOptions); ok {
+		return o
+	}
+	return callOptions{}
+}
+
+// suppressNotification rewrites the JSON body of a request not to notify users
+func suppressNotification(req *http.Request) error {
+	if req.GetBody == nil || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.NewDecoder(body).Decode(&fields); err != nil {
+		// not an object
+		return nil
+	}
+	delete(fields, "notifiedUserId")
+	if _, ok := fields["mailNotify"]; ok {
+		fields["mailNotify"] = json.RawMessage("false")
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	req.ContentLength = int64(len(b))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+	return nil
+}
+
+// isRetryableStatus returns if a request may succeed when retried after the response
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code/100 == 5
+}
@@ -0,0 +1,136 @@
+package backlog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallOptionHeaderAndResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "proxy", r.Header.Get("X-Via"))
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		if _, err := w.Write([]byte(`{"id": 1, "issueKey": "BLG-1"}`)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var res CallResponse
+	issue, err := client.GetIssueContext(context.Background(), "BLG-1", WithHeader("X-Via", "proxy"), WithResponse(&res))
+	assert.NoError(t, err)
+	assert.Equal(t, "BLG-1", *issue.IssueKey)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "99", res.Header.Get("X-RateLimit-Remaining"))
+}
+
+func TestCallOptionTimeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	_, err := client.GetIssueContext(context.Background(), "BLG-1", WithTimeout(10*time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestCallOptionRetry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRetryPolicy(RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond})(client)
+
+	var count int32
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&count, 1)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if _, err := w.Write([]byte(`{"id": 1, "issueKey": "BLG-1"}`)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	issue, err := client.GetIssue("BLG-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "BLG-1", *issue.IssueKey)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+
+	_, err = client.GetIssueContext(context.Background(), "BLG-1", WithoutRetry())
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&count))
+}
+
+func TestCallOptionDryRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues/BLG-1", func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+
+	issue, err := client.UpdateIssueContext(context.Background(), "BLG-1", &UpdateIssueInput{Summary: String("new summary")}, WithDryRun(true))
+	assert.NoError(t, err)
+	assert.Equal(t, "new summary", *issue.Summary)
+	assert.Len(t, client.DryRunRequests(), 1)
+}
+
+func TestCallOptionWithoutNotification(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wikis/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name": "Home", "content": "hi", "mailNotify": false}`, string(b))
+		if _, err := w.Write([]byte(`{"id": 1, "name": "Home"}`)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	_, err := client.UpdateWikiContext(context.Background(), 1, &UpdateWikiInput{Name: String("Home"), Content: String("hi"), MailNotify: Bool(true)}, WithoutNotification())
+	assert.NoError(t, err)
+}
+
+func TestCallOptionWithoutCoalescing(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRequestCoalescing(true)(client)
+
+	var count int32
+	release := make(chan struct{})
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&count, 1)
+		<-release
+		if _, err := w.Write([]byte(`{"id": 1, "issueKey": "BLG-1"}`)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := client.GetIssueContext(context.Background(), "BLG-1", WithoutCoalescing())
+			done <- err
+		}()
+	}
+	for atomic.LoadInt32(&count) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, <-done)
+	}
+}
@@ -18,7 +18,8 @@ func (c *Client) GetCategories(projectIDOrKey interface{}) ([]*Category, error)
 }
 
 // GetCategoriesContext returns the list of categories with context
-func (c *Client) GetCategoriesContext(ctx context.Context, projectIDOrKey interface{}) ([]*Category, error) {
+func (c *Client) GetCategoriesContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) ([]*Category, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/categories", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -39,7 +40,8 @@ func (c *Client) CreateCategory(projectIDOrKey interface{}, input *CreateCategor
 }
 
 // CreateCategoryContext creates a category with Context
-func (c *Client) CreateCategoryContext(ctx context.Context, projectIDOrKey interface{}, input *CreateCategoryInput) (*Category, error) {
+func (c *Client) CreateCategoryContext(ctx context.Context, projectIDOrKey interface{}, input *CreateCategoryInput, callOpts ...CallOption) (*Category, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/categories", projectIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -60,7 +62,8 @@ func (c *Client) UpdateCategory(projectIDOrKey interface{}, categoryID int, inpu
 }
 
 // UpdateCategoryContext updates a category with Context
-func (c *Client) UpdateCategoryContext(ctx context.Context, projectIDOrKey interface{}, categoryID int, input *UpdateCategoryInput) (*Category, error) {
+func (c *Client) UpdateCategoryContext(ctx context.Context, projectIDOrKey interface{}, categoryID int, input *UpdateCategoryInput, callOpts ...CallOption) (*Category, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/categories/%v", projectIDOrKey, categoryID)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -81,7 +84,8 @@ func (c *Client) DeleteCategory(projectIDOrKey interface{}, categoryID int) (*Ca
 }
 
 // DeleteCategoryContext deletes a category with Context
-func (c *Client) DeleteCategoryContext(ctx context.Context, projectIDOrKey interface{}, categoryID int) (*Category, error) {
+func (c *Client) DeleteCategoryContext(ctx context.Context, projectIDOrKey interface{}, categoryID int, callOpts ...CallOption) (*Category, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/categories/%v", projectIDOrKey, categoryID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
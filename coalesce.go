@@ -0,0 +1,106 @@
+package backlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// coalescer shares the responses of identical GET requests in flight
+type coalescer struct {
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+// coalescedCall : a request in flight and the number of callers waiting for it
+type coalescedCall struct {
+	done    chan struct{}
+	body    []byte
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+	// deadline is the deadline of the first caller, or zero if it has none
+	deadline time.Time
+}
+
+func newCoalescer() *coalescer {
+	return &coalescer{calls: map[string]*coalescedCall{}}
+}
+
+// doCoalesced sends a GET request, or waits for the identical request in flight.
+// The request is canceled only when all the callers waiting for it are canceled,
+// or when the deadline of the first caller is exceeded. Callers whose deadline
+// is later than it send their own requests not to fail early.
+func (c *Client) doCoalesced(ctx context.Context, req *http.Request, v interface{}) error {
+	key := req.Method + " " + req.URL.String()
+	co := c.coalescer
+	deadline, hasDeadline := ctx.Deadline()
+
+	co.mu.Lock()
+	call, ok := co.calls[key]
+	if ok && !call.deadline.IsZero() && (!hasDeadline || deadline.After(call.deadline)) {
+		co.mu.Unlock()
+		return c.do(ctx, req, v)
+	}
+	if !ok {
+		var sctx context.Context
+		var cancel context.CancelFunc
+		if hasDeadline {
+			sctx, cancel = context.WithDeadline(context.WithoutCancel(ctx), deadline)
+		} else {
+			sctx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+		}
+		call = &coalescedCall{done: make(chan struct{}), cancel: cancel, deadline: deadline}
+		co.calls[key] = call
+		go func() {
+			var buf bytes.Buffer
+			call.err = c.do(sctx, req, &buf)
+			call.body = buf.Bytes()
+
+			co.mu.Lock()
+			if co.calls[key] == call {
+				delete(co.calls, key)
+			}
+			co.mu.Unlock()
+			cancel()
+			close(call.done)
+		}()
+	}
+	call.waiters++
+	co.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		co.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			if co.calls[key] == call {
+				delete(co.calls, key)
+			}
+			call.cancel()
+		}
+		co.mu.Unlock()
+		return ctx.Err()
+	}
+
+	if call.err != nil {
+		return call.err
+	}
+	if v == nil {
+		return nil
+	}
+	// each caller decodes its own copy of the response
+	if w, ok := v.(io.Writer); ok {
+		_, err := w.Write(call.body)
+		return err
+	}
+	if err := json.Unmarshal(call.body, v); err != nil && len(bytes.TrimSpace(call.body)) > 0 {
+		return err
+	}
+	return nil
+}
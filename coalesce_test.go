@@ -0,0 +1,158 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// waitCoalescedWaiters waits until n callers wait for a request in flight
+func waitCoalescedWaiters(t *testing.T, client *Client, n int) {
+	t.Helper()
+	for i := 0; i < 1000; i++ {
+		client.coalescer.mu.Lock()
+		waiters := 0
+		for _, call := range client.coalescer.calls {
+			waiters += call.waiters
+		}
+		client.coalescer.mu.Unlock()
+		if waiters == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("callers did not wait for the request")
+}
+
+func TestRequestCoalescing(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRequestCoalescing(true)(client)
+
+	var hits int32
+	release := make(chan struct{})
+	mux.HandleFunc("/projects/TEST", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		if _, err := fmt.Fprint(w, `{"id": 1, "projectKey": "TEST", "name": "test"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	projects := make([]*Project, 5)
+	var wg sync.WaitGroup
+	for i := range projects {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			project, err := client.GetProject("TEST")
+			if assert.NoError(t, err) {
+				projects[i] = project
+			}
+		}(i)
+	}
+	waitCoalescedWaiters(t, client, 5)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	*projects[0].Name = "changed"
+	for _, project := range projects[1:] {
+		assert.Equal(t, "test", *project.Name)
+	}
+}
+
+func TestRequestCoalescingCancel(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRequestCoalescing(true)(client)
+
+	release := make(chan struct{})
+	mux.HandleFunc("/projects/TEST", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		if _, err := fmt.Fprint(w, `{"id": 1, "projectKey": "TEST"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 2)
+	go func() {
+		_, err := client.GetProjectContext(ctx, "TEST")
+		errc <- err
+	}()
+	go func() {
+		_, err := client.GetProjectContext(context.Background(), "TEST")
+		errc <- err
+	}()
+	waitCoalescedWaiters(t, client, 2)
+
+	// the cancellation of one caller does not cancel the other
+	cancel()
+	assert.ErrorIs(t, <-errc, context.Canceled)
+	close(release)
+	assert.NoError(t, <-errc)
+}
+
+func TestRequestCoalescingDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionRequestCoalescing(true)(client)
+
+	var hits int32
+	release := make(chan struct{})
+	mux.HandleFunc("/projects/TEST", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		if _, err := fmt.Fprint(w, `{"id": 1, "projectKey": "TEST"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	errc := make(chan error, 3)
+	get := func(ctx context.Context, callOpts ...CallOption) {
+		_, err := client.GetProjectContext(ctx, "TEST", callOpts...)
+		errc <- err
+	}
+	go get(context.Background(), WithTimeout(time.Minute))
+	waitCoalescedWaiters(t, client, 1)
+
+	// the shared request has the deadline of the first caller
+	client.coalescer.mu.Lock()
+	for _, call := range client.coalescer.calls {
+		assert.False(t, call.deadline.IsZero())
+	}
+	client.coalescer.mu.Unlock()
+
+	// a caller with an earlier deadline shares the request
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	go get(ctx)
+	waitCoalescedWaiters(t, client, 2)
+
+	// a caller without a deadline sends its own request
+	go get(context.Background())
+	for i := 0; i < 1000 && atomic.LoadInt32(&hits) < 2; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+
+	close(release)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, <-errc)
+	}
+}
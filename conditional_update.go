@@ -0,0 +1,179 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// defaultConditionalUpdateRetries is the number of retries with Merge by default
+const defaultConditionalUpdateRetries = 3
+
+// ConflictError is returned by conditional updates when the resource has been
+// changed since the caller saw it. Either Issue or Wiki holds the current version.
+type ConflictError struct {
+	Issue *Issue
+	Wiki  *Wiki
+}
+
+func (e *ConflictError) Error() string {
+	var name string
+	var updated *Timestamp
+	var user *User
+	if e.Issue != nil {
+		name = "issue " + derefString(e.Issue.IssueKey)
+		updated, user = e.Issue.Updated, e.Issue.UpdatedUser
+	} else if e.Wiki != nil {
+		name = "wiki " + derefString(e.Wiki.Name)
+		updated, user = e.Wiki.Updated, e.Wiki.UpdatedUser
+	}
+	msg := fmt.Sprintf("%s has been changed", name)
+	if updated != nil {
+		msg += " at " + updated.Format(time.RFC3339)
+	}
+	if user != nil {
+		msg += " by " + derefString(user.Name)
+	}
+	return msg
+}
+
+// UpdateIssueCondition specifies the state an issue must be in to be updated.
+// Either Updated or Snapshot must be set.
+type UpdateIssueCondition struct {
+	// Updated is the updated time of the issue the caller saw
+	Updated time.Time
+	// Snapshot is the issue the caller saw, as returned by GetIssue. The
+	// fields compared by DiffIssues must be unchanged, so updates of other
+	// fields such as comments and stars are not conflicts. Fields left nil in
+	// a partial snapshot are compared as empty, and conflict with set values.
+	Snapshot *Issue
+	// Merge is called on a conflict with the current issue and returns the
+	// input to retry with. Returning nil gives up with ConflictError.
+	Merge func(current *Issue, input *UpdateIssueInput) (*UpdateIssueInput, error)
+	// MaxRetries is the number of retries with Merge. The default is 3.
+	MaxRetries int
+}
+
+// UpdateWikiCondition specifies the state a wiki must be in to be updated.
+// Either Updated or Snapshot must be set.
+type UpdateWikiCondition struct {
+	// Updated is the updated time of the wiki the caller saw
+	Updated time.Time
+	// Snapshot is the wiki the caller saw. Its name and content must be unchanged.
+	Snapshot *Wiki
+	// Merge is called on a conflict with the current wiki and returns the
+	// input to retry with. Returning nil gives up with ConflictError.
+	Merge func(current *Wiki, input *UpdateWikiInput) (*UpdateWikiInput, error)
+	// MaxRetries is the number of retries with Merge. The default is 3.
+	MaxRetries int
+}
+
+// UpdateIssueIfUnchanged updates an issue only if it is unchanged since the caller saw it
+func (c *Client) UpdateIssueIfUnchanged(issueIDOrKey string, cond *UpdateIssueCondition, input *UpdateIssueInput) (*Issue, error) {
+	return c.UpdateIssueIfUnchangedContext(context.Background(), issueIDOrKey, cond, input)
+}
+
+// UpdateIssueIfUnchangedContext updates an issue only if it is unchanged since the caller saw it with context.
+//
+// Backlog has no conditional requests, so the issue is fetched and compared
+// just before the update. This narrows the window for lost updates but does
+// not close it.
+func (c *Client) UpdateIssueIfUnchangedContext(ctx context.Context, issueIDOrKey string, cond *UpdateIssueCondition, input *UpdateIssueInput, callOpts ...CallOption) (*Issue, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	if cond == nil || (cond.Updated.IsZero() && cond.Snapshot == nil) {
+		return nil, errors.New("updated time or snapshot must be specified")
+	}
+	updated, snapshot := cond.Updated, cond.Snapshot
+	retries := cond.MaxRetries
+	if retries <= 0 {
+		retries = defaultConditionalUpdateRetries
+	}
+
+	for i := 0; ; i++ {
+		current, err := c.GetIssueContext(ctx, issueIDOrKey)
+		if err != nil {
+			return nil, err
+		}
+
+		changed := false
+		if snapshot != nil {
+			changed = len(DiffIssues(snapshot, current)) > 0
+		} else {
+			changed = current.Updated == nil || !current.Updated.Equal(updated)
+		}
+		if !changed {
+			return c.UpdateIssueContext(ctx, issueIDOrKey, input)
+		}
+
+		if cond.Merge == nil || i >= retries {
+			return nil, &ConflictError{Issue: current}
+		}
+		if input, err = cond.Merge(current, input); err != nil {
+			return nil, err
+		}
+		if input == nil {
+			return nil, &ConflictError{Issue: current}
+		}
+		if snapshot != nil {
+			snapshot = current
+		} else if current.Updated != nil {
+			updated = current.Updated.Time
+		}
+	}
+}
+
+// UpdateWikiIfUnchanged updates a wiki only if it is unchanged since the caller saw it
+func (c *Client) UpdateWikiIfUnchanged(wikiID int, cond *UpdateWikiCondition, input *UpdateWikiInput) (*Wiki, error) {
+	return c.UpdateWikiIfUnchangedContext(context.Background(), wikiID, cond, input)
+}
+
+// UpdateWikiIfUnchangedContext updates a wiki only if it is unchanged since the caller saw it with context.
+//
+// As with UpdateIssueIfUnchangedContext, the wiki is compared just before the
+// update, which narrows the window for lost updates but does not close it.
+func (c *Client) UpdateWikiIfUnchangedContext(ctx context.Context, wikiID int, cond *UpdateWikiCondition, input *UpdateWikiInput, callOpts ...CallOption) (*Wiki, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	if cond == nil || (cond.Updated.IsZero() && cond.Snapshot == nil) {
+		return nil, errors.New("updated time or snapshot must be specified")
+	}
+	updated, snapshot := cond.Updated, cond.Snapshot
+	retries := cond.MaxRetries
+	if retries <= 0 {
+		retries = defaultConditionalUpdateRetries
+	}
+
+	for i := 0; ; i++ {
+		current, err := c.GetWikiContext(ctx, wikiID)
+		if err != nil {
+			return nil, err
+		}
+
+		changed := false
+		if snapshot != nil {
+			changed = derefString(snapshot.Name) != derefString(current.Name) ||
+				derefString(snapshot.Content) != derefString(current.Content)
+		} else {
+			changed = current.Updated == nil || !current.Updated.Equal(updated)
+		}
+		if !changed {
+			return c.UpdateWikiContext(ctx, wikiID, input)
+		}
+
+		if cond.Merge == nil || i >= retries {
+			return nil, &ConflictError{Wiki: current}
+		}
+		if input, err = cond.Merge(current, input); err != nil {
+			return nil, err
+		}
+		if input == nil {
+			return nil, &ConflictError{Wiki: current}
+		}
+		if snapshot != nil {
+			snapshot = current
+		} else if current.Updated != nil {
+			updated = current.Updated.Time
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateIssueIfUnchanged(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	seen := time.Date(2019, 1, 7, 0, 0, 0, 0, time.UTC)
+	current := getTestIssue()
+	current.Summary = String("summary")
+	current.UpdatedUser = &User{ID: Int(1), Name: String("bot")}
+	current.Updated = &Timestamp{seen}
+	var patched []map[string]interface{}
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			var input map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+			patched = append(patched, input)
+		}
+		if err := json.NewEncoder(w).Encode(current); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	_, err := client.UpdateIssueIfUnchanged("BLG-1", &UpdateIssueCondition{Updated: seen}, &UpdateIssueInput{Summary: String("a")})
+	assert.NoError(t, err)
+	assert.Len(t, patched, 1)
+
+	current.Updated = &Timestamp{time.Date(2019, 1, 8, 0, 0, 0, 0, time.UTC)}
+	_, err = client.UpdateIssueIfUnchanged("BLG-1", &UpdateIssueCondition{Updated: seen}, &UpdateIssueInput{Summary: String("b")})
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected ConflictError but got %v", err)
+	}
+	assert.Equal(t, "BLG-1", *conflict.Issue.IssueKey)
+	assert.EqualError(t, err, "issue BLG-1 has been changed at 2019-01-08T00:00:00Z by bot")
+	assert.Len(t, patched, 1)
+
+	// an update of a field not compared by DiffIssues is not a conflict
+	snapshot := getTestIssue()
+	snapshot.Summary = String("summary")
+	current.Stars = append(current.Stars, &Star{ID: Int(11)})
+	_, err = client.UpdateIssueIfUnchanged("BLG-1", &UpdateIssueCondition{
+		Snapshot: snapshot,
+	}, &UpdateIssueInput{Description: String("c")})
+	assert.NoError(t, err)
+	assert.Len(t, patched, 2)
+
+	current.Assignee = nil
+	_, err = client.UpdateIssueIfUnchanged("BLG-1", &UpdateIssueCondition{
+		Snapshot: snapshot,
+	}, &UpdateIssueInput{Description: String("c")})
+	assert.True(t, errors.As(err, &conflict))
+	assert.Len(t, patched, 2)
+
+	merged := 0
+	_, err = client.UpdateIssueIfUnchanged("BLG-1", &UpdateIssueCondition{
+		Updated: seen,
+		Merge: func(current *Issue, input *UpdateIssueInput) (*UpdateIssueInput, error) {
+			merged++
+			return &UpdateIssueInput{Summary: String(*current.Summary + " merged")}, nil
+		},
+	}, &UpdateIssueInput{Summary: String("d")})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, merged)
+	assert.Equal(t, "summary merged", patched[2]["summary"])
+
+	_, err = client.UpdateIssueIfUnchanged("BLG-1", &UpdateIssueCondition{
+		Updated: seen,
+		Merge: func(*Issue, *UpdateIssueInput) (*UpdateIssueInput, error) {
+			return nil, nil
+		},
+	}, &UpdateIssueInput{Summary: String("e")})
+	assert.True(t, errors.As(err, &conflict))
+
+	_, err = client.UpdateIssueIfUnchanged("BLG-1", &UpdateIssueCondition{}, &UpdateIssueInput{})
+	assert.Error(t, err)
+}
+
+func TestUpdateWikiIfUnchanged(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	patched := 0
+	mux.HandleFunc("/wikis/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			patched++
+		}
+		if _, err := fmt.Fprint(w, `{"id": 1, "name": "Home", "content": "current", "updated": "2019-01-08T00:00:00Z"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	_, err := client.UpdateWikiIfUnchanged(1, &UpdateWikiCondition{
+		Snapshot: &Wiki{Name: String("Home"), Content: String("old")},
+	}, &UpdateWikiInput{Name: String("Home"), Content: String("new")})
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected ConflictError but got %v", err)
+	}
+	assert.Equal(t, "current", *conflict.Wiki.Content)
+	assert.Equal(t, 0, patched)
+
+	_, err = client.UpdateWikiIfUnchanged(1, &UpdateWikiCondition{
+		Updated: time.Date(2019, 1, 7, 0, 0, 0, 0, time.UTC),
+		Merge: func(current *Wiki, input *UpdateWikiInput) (*UpdateWikiInput, error) {
+			return &UpdateWikiInput{Name: current.Name, Content: String(*current.Content + "\nnew")}, nil
+		},
+	}, &UpdateWikiInput{Name: String("Home"), Content: String("new")})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, patched)
+}
@@ -0,0 +1,47 @@
+package backlog
+
+import (
+	"iter"
+)
+
+// cursorSeq returns an iterator over the items of an endpoint paged by minId
+// and maxId. fetch gets a page of up to count items between minID and maxID,
+// starting from the given ones; after each page, minID or maxID is moved to
+// the last item by the order. Items are yielded once even if pages overlap,
+// and iteration stops at the first error.
+func cursorSeq[T any](count int, asc bool, minID, maxID *int, id func(T) *int, fetch func(minID, maxID *int) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		// each iteration starts from the given cursor
+		minID, maxID := minID, maxID
+		seen := map[int]bool{}
+		for {
+			items, err := fetch(minID, maxID)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			var last *int
+			for _, item := range items {
+				if i := id(item); i != nil {
+					if seen[*i] {
+						continue
+					}
+					seen[*i] = true
+					last = i
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) < count || last == nil {
+				return
+			}
+			if asc {
+				minID = Int(*last)
+			} else {
+				maxID = Int(*last)
+			}
+		}
+	}
+}
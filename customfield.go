@@ -30,7 +30,8 @@ func (c *Client) GetCustomFields(projectIDOrKey interface{}) ([]*CustomField, er
 }
 
 // GetCustomFieldsContext returns the list of custom fields with context
-func (c *Client) GetCustomFieldsContext(ctx context.Context, projectIDOrKey interface{}) ([]*CustomField, error) {
+func (c *Client) GetCustomFieldsContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) ([]*CustomField, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/customFields", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
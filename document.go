@@ -0,0 +1,264 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// maxDocumentCount is the maximum count of documents in a request
+const maxDocumentCount = 100
+
+// Document : document
+type Document struct {
+	ID          *string       `json:"id,omitempty"`
+	ProjectID   *int          `json:"projectId,omitempty"`
+	Title       *string       `json:"title,omitempty"`
+	Plain       *string       `json:"plain,omitempty"`
+	JSON        *string       `json:"json,omitempty"`
+	StatusID    *int          `json:"statusId,omitempty"`
+	Emoji       *string       `json:"emoji,omitempty"`
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	Tags        []*Tag        `json:"tags,omitempty"`
+	CreatedUser *User         `json:"createdUser,omitempty"`
+	Created     *Timestamp    `json:"created,omitempty"`
+	UpdatedUser *User         `json:"updatedUser,omitempty"`
+	Updated     *Timestamp    `json:"updated,omitempty"`
+}
+
+// DocumentTree : the tree of documents in a project
+type DocumentTree struct {
+	ProjectID  *int              `json:"projectId,omitempty"`
+	ActiveTree *DocumentTreeNode `json:"activeTree,omitempty"`
+	TrashTree  *DocumentTreeNode `json:"trashTree,omitempty"`
+}
+
+// DocumentTreeNode : a document in the tree of documents
+type DocumentTreeNode struct {
+	ID       *string             `json:"id,omitempty"`
+	Name     *string             `json:"name,omitempty"`
+	Emoji    *string             `json:"emoji,omitempty"`
+	Children []*DocumentTreeNode `json:"children,omitempty"`
+	Updated  *Timestamp          `json:"updated,omitempty"`
+}
+
+// Walk calls fn for the node and its descendants in depth-first order with
+// their depth from the node. If fn returns false, the children of the node are skipped.
+func (n *DocumentTreeNode) Walk(fn func(node *DocumentTreeNode, depth int) bool) {
+	n.walk(fn, 0)
+}
+
+func (n *DocumentTreeNode) walk(fn func(node *DocumentTreeNode, depth int) bool, depth int) {
+	if n == nil || !fn(n, depth) {
+		return
+	}
+	for _, child := range n.Children {
+		child.walk(fn, depth+1)
+	}
+}
+
+// GetDocuments returns the list of documents
+func (c *Client) GetDocuments(opts *GetDocumentsOptions) ([]*Document, error) {
+	return c.GetDocumentsContext(context.Background(), opts)
+}
+
+// GetDocumentsContext returns the list of documents with context
+func (c *Client) GetDocumentsContext(ctx context.Context, opts *GetDocumentsOptions, callOpts ...CallOption) ([]*Document, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u, err := c.AddOptions("/api/v2/documents", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	documents := []*Document{}
+	if err := c.Do(ctx, req, &documents); err != nil {
+		return nil, err
+	}
+	return documents, nil
+}
+
+// GetDocumentTree returns the tree of documents in a project
+func (c *Client) GetDocumentTree(projectIDOrKey interface{}) (*DocumentTree, error) {
+	return c.GetDocumentTreeContext(context.Background(), projectIDOrKey)
+}
+
+// GetDocumentTreeContext returns the tree of documents in a project with context
+func (c *Client) GetDocumentTreeContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) (*DocumentTree, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u, err := c.AddOptions("/api/v2/documents/tree", &getDocumentTreeOptions{ProjectIDOrKey: projectIDOrKey})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := new(DocumentTree)
+	if err := c.Do(ctx, req, &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// GetDocumentTags returns the tags of documents, in ascending order of IDs.
+// Backlog has no endpoint for them, so they are collected from all the
+// documents matching opts, where Offset and Count are ignored.
+func (c *Client) GetDocumentTags(opts *GetDocumentsOptions) ([]*Tag, error) {
+	return c.GetDocumentTagsContext(context.Background(), opts)
+}
+
+// GetDocumentTagsContext returns the tags of documents with context
+func (c *Client) GetDocumentTagsContext(ctx context.Context, opts *GetDocumentsOptions, callOpts ...CallOption) ([]*Tag, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	o := GetDocumentsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	o.Count = Int(maxDocumentCount)
+
+	tags := []*Tag{}
+	seen := map[int]bool{}
+	for offset := 0; ; offset += maxDocumentCount {
+		o.Offset = Int(offset)
+		documents, err := c.GetDocumentsContext(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		for _, document := range documents {
+			for _, tag := range document.Tags {
+				if tag.ID == nil || seen[*tag.ID] {
+					continue
+				}
+				seen[*tag.ID] = true
+				tags = append(tags, tag)
+			}
+		}
+		if len(documents) < maxDocumentCount {
+			break
+		}
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return *tags[i].ID < *tags[j].ID
+	})
+	return tags, nil
+}
+
+// GetDocument returns document by id
+func (c *Client) GetDocument(documentID string) (*Document, error) {
+	return c.GetDocumentContext(context.Background(), documentID)
+}
+
+// GetDocumentContext returns document by id with context
+func (c *Client) GetDocumentContext(ctx context.Context, documentID string, callOpts ...CallOption) (*Document, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/documents/%v", documentID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	document := new(Document)
+	if err := c.Do(ctx, req, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// CreateDocument creates a document
+func (c *Client) CreateDocument(input *CreateDocumentInput) (*Document, error) {
+	return c.CreateDocumentContext(context.Background(), input)
+}
+
+// CreateDocumentContext creates a document with Context
+func (c *Client) CreateDocumentContext(ctx context.Context, input *CreateDocumentInput, callOpts ...CallOption) (*Document, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := "/api/v2/documents"
+
+	req, err := c.NewRequest("POST", u, input)
+	if err != nil {
+		return nil, err
+	}
+
+	document := new(Document)
+	if err := c.Do(ctx, req, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// DeleteDocument deletes a document
+func (c *Client) DeleteDocument(documentID string) (*Document, error) {
+	return c.DeleteDocumentContext(context.Background(), documentID)
+}
+
+// DeleteDocumentContext deletes a document with Context
+func (c *Client) DeleteDocumentContext(ctx context.Context, documentID string, callOpts ...CallOption) (*Document, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/documents/%v", documentID)
+
+	req, err := c.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	document := new(Document)
+	if err := c.Do(ctx, req, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// GetDocumentAttachment downloads an attachment of a document
+func (c *Client) GetDocumentAttachment(documentID string, attachmentID int, w io.Writer) error {
+	return c.GetDocumentAttachmentContext(context.Background(), documentID, attachmentID, w)
+}
+
+// GetDocumentAttachmentContext downloads an attachment of a document with context
+func (c *Client) GetDocumentAttachmentContext(ctx context.Context, documentID string, attachmentID int, w io.Writer, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/documents/%v/attachments/%v", documentID, attachmentID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, w); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetDocumentsOptions specifies parameters to the GetDocuments method.
+type GetDocumentsOptions struct {
+	ProjectIDs []int   `url:"projectId[],omitempty"`
+	Keyword    *string `url:"keyword,omitempty"`
+	Sort       *string `url:"sort,omitempty"`
+	Order      Order   `url:"order,omitempty"`
+	Offset     *int    `url:"offset,omitempty"`
+	Count      *int    `url:"count,omitempty"`
+}
+
+type getDocumentTreeOptions struct {
+	ProjectIDOrKey interface{} `url:"projectIdOrKey"`
+}
+
+// CreateDocumentInput contains all the parameters necessary (including the optional ones) for a CreateDocument() request.
+type CreateDocumentInput struct {
+	ProjectID *int    `json:"projectId"`
+	Title     *string `json:"title,omitempty"`
+	Content   *string `json:"content,omitempty"`
+	Emoji     *string `json:"emoji,omitempty"`
+	ParentID  *string `json:"parentId,omitempty"`
+	AddLast   *bool   `json:"addLast,omitempty"`
+}
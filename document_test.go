@@ -0,0 +1,206 @@
+package backlog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testJSONDocument = `{
+	"id": "0193a3b1d8b3713c8ecf5c0f4b8ba23f",
+	"projectId": 1,
+	"title": "Runbook",
+	"plain": "restart the server",
+	"json": "{}",
+	"statusId": 1,
+	"emoji": "📘",
+	"attachments": [{"id": 1, "name": "diagram.png", "size": 100}],
+	"tags": [{"id": 2, "name": "ops"}],
+	"createdUser": {"id": 1, "name": "eguchi"},
+	"created": "2006-01-02T15:04:05Z",
+	"updatedUser": {"id": 1, "name": "eguchi"},
+	"updated": "2006-01-02T15:04:05Z"
+}`
+
+func getTestDocument() *Document {
+	return &Document{
+		ID:          String("0193a3b1d8b3713c8ecf5c0f4b8ba23f"),
+		ProjectID:   Int(1),
+		Title:       String("Runbook"),
+		Plain:       String("restart the server"),
+		JSON:        String("{}"),
+		StatusID:    Int(1),
+		Emoji:       String("📘"),
+		Attachments: []*Attachment{{ID: Int(1), Name: String("diagram.png"), Size: Int(100)}},
+		Tags:        []*Tag{{ID: Int(2), Name: String("ops")}},
+		CreatedUser: &User{ID: Int(1), Name: String("eguchi")},
+		Created:     &Timestamp{referenceTime},
+		UpdatedUser: &User{ID: Int(1), Name: String("eguchi")},
+		Updated:     &Timestamp{referenceTime},
+	}
+}
+
+func TestGetDocuments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, []string{"1"}, r.URL.Query()["projectId[]"])
+		assert.Equal(t, "runbook", r.URL.Query().Get("keyword"))
+		if _, err := fmt.Fprintf(w, "[%s]", testJSONDocument); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	documents, err := client.GetDocuments(&GetDocumentsOptions{ProjectIDs: []int{1}, Keyword: String("runbook")})
+	assert.NoError(t, err)
+	assert.Equal(t, []*Document{getTestDocument()}, documents)
+}
+
+func TestGetDocumentsFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetDocuments(&GetDocumentsOptions{})
+	assert.Error(t, err)
+}
+
+func TestGetDocumentTree(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents/tree", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "TEST", r.URL.Query().Get("projectIdOrKey"))
+		if _, err := fmt.Fprint(w, `{
+			"projectId": 1,
+			"activeTree": {"id": "Active", "children": [
+				{"id": "a", "name": "Ops", "children": [{"id": "b", "name": "Runbook"}]},
+				{"id": "c", "name": "Guide"}
+			]},
+			"trashTree": {"id": "Trash"}
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	tree, err := client.GetDocumentTree("TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *tree.ProjectID)
+	assert.Equal(t, "Trash", *tree.TrashTree.ID)
+
+	var names []string
+	tree.ActiveTree.Walk(func(node *DocumentTreeNode, depth int) bool {
+		if node.Name != nil {
+			names = append(names, strings.Repeat("  ", depth-1)+*node.Name)
+		}
+		return derefString(node.Name) != "Ops"
+	})
+	assert.Equal(t, []string{"Ops", "Guide"}, names)
+}
+
+func TestGetDocumentTags(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// 150 documents in pages of 100
+	mux.HandleFunc("/documents", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var items []string
+		for i := offset; i < 150 && i < offset+100; i++ {
+			items = append(items, fmt.Sprintf(`{"id": "%d", "tags": [{"id": %d, "name": "tag%d"}]}`, i, i%3+1, i%3+1))
+		}
+		if _, err := fmt.Fprintf(w, "[%s]", strings.Join(items, ",")); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	tags, err := client.GetDocumentTags(&GetDocumentsOptions{ProjectIDs: []int{1}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*Tag{
+		{ID: Int(1), Name: String("tag1")},
+		{ID: Int(2), Name: String("tag2")},
+		{ID: Int(3), Name: String("tag3")},
+	}, tags)
+}
+
+func TestGetDocument(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents/0193a3b1d8b3713c8ecf5c0f4b8ba23f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, testJSONDocument); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	document, err := client.GetDocument("0193a3b1d8b3713c8ecf5c0f4b8ba23f")
+	assert.NoError(t, err)
+	assert.Equal(t, getTestDocument(), document)
+}
+
+func TestCreateDocument(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"projectId": 1, "title": "Runbook", "content": "restart the server", "addLast": true}`, string(b))
+		if _, err := fmt.Fprint(w, testJSONDocument); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	document, err := client.CreateDocument(&CreateDocumentInput{
+		ProjectID: Int(1),
+		Title:     String("Runbook"),
+		Content:   String("restart the server"),
+		AddLast:   Bool(true),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, getTestDocument(), document)
+}
+
+func TestDeleteDocument(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents/0193a3b1d8b3713c8ecf5c0f4b8ba23f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if _, err := fmt.Fprint(w, testJSONDocument); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	document, err := client.DeleteDocument("0193a3b1d8b3713c8ecf5c0f4b8ba23f")
+	assert.NoError(t, err)
+	assert.Equal(t, getTestDocument(), document)
+}
+
+func TestGetDocumentAttachment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents/0193a3b1d8b3713c8ecf5c0f4b8ba23f/attachments/1", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := w.Write([]byte("png")); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var buf bytes.Buffer
+	assert.NoError(t, client.GetDocumentAttachment("0193a3b1d8b3713c8ecf5c0f4b8ba23f", 1, &buf))
+	assert.Equal(t, "png", buf.String())
+}
@@ -0,0 +1,196 @@
+package backlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ReadOnlyError is returned for mutating requests by a Client with OptionReadOnly
+type ReadOnlyError struct {
+	Method string
+	Path   string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("read-only client refused %s %s", e.Method, e.Path)
+}
+
+// DryRunRequest : a request intercepted by a Client with OptionDryRun.
+// Query excludes the API key, and Payload is the JSON body or, for a
+// multipart body, the summary of its parts.
+type DryRunRequest struct {
+	Method  string
+	Path    string
+	Query   string
+	Payload string
+}
+
+func (r *DryRunRequest) String() string {
+	s := r.Method + " " + r.Path
+	if r.Query != "" {
+		s += "?" + r.Query
+	}
+	if r.Payload != "" {
+		s += " " + r.Payload
+	}
+	return s
+}
+
+// DryRunRequests returns the requests intercepted so far in dry-run mode
+func (c *Client) DryRunRequests() []*DryRunRequest {
+	c.dryRunMu.Lock()
+	defer c.dryRunMu.Unlock()
+	return append([]*DryRunRequest{}, c.dryRuns...)
+}
+
+// isSafeMethod returns if the method does not modify resources
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// refuseRequest fails a mutating request in read-only mode
+func (c *Client) refuseRequest(req *http.Request) error {
+	// drain the body so that a writer of the body such as UploadMultipartFile finishes
+	if req.Body != nil {
+		if _, err := io.Copy(io.Discard, req.Body); err != nil {
+			return err
+		}
+		if err := req.Body.Close(); err != nil {
+			return err
+		}
+	}
+	return &ReadOnlyError{Method: req.Method, Path: req.URL.Path}
+}
+
+// dryRunIssuePath matches the paths of an issue and of the list of issues
+var dryRunIssuePath = regexp.MustCompile(`/api/v2/issues(?:/([^/]+))?$`)
+
+// interceptRequest records a mutating request in dry-run mode and decodes its
+// payload into v as a plausible response
+func (c *Client) interceptRequest(req *http.Request, v interface{}) error {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return err
+		}
+		if err := req.Body.Close(); err != nil {
+			return err
+		}
+		body = b
+	}
+
+	q := req.URL.Query()
+	q.Del("apiKey")
+	r := &DryRunRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  q.Encode(),
+	}
+
+	response := body
+	mediaType, params, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if strings.HasPrefix(mediaType, "multipart/") {
+		var file map[string]interface{}
+		r.Payload, file = multipartSummary(body, params["boundary"])
+		response = nil
+		if file != nil {
+			response, _ = json.Marshal(file)
+		}
+	} else {
+		r.Payload = strings.TrimSpace(string(body))
+	}
+
+	c.dryRunMu.Lock()
+	c.dryRuns = append(c.dryRuns, r)
+	response = c.dryRunPlaceholders(req, response)
+	c.dryRunMu.Unlock()
+	c.log.Printf("[dry-run] %s", r)
+
+	if _, ok := v.(io.Writer); ok || v == nil || len(response) == 0 {
+		return nil
+	}
+	// the payload may not fit the response type, so decoding errors are ignored
+	_ = json.Unmarshal(response, v)
+	return nil
+}
+
+// dryRunPlaceholders fills in the fields callers rely on which the payload
+// lacks: a negative placeholder "id" for created resources, and "id" and
+// "issueKey" for issues, so that an issue keeps the same ones across requests.
+// c.dryRunMu must be held.
+func (c *Client) dryRunPlaceholders(req *http.Request, response []byte) []byte {
+	var obj map[string]interface{}
+	if len(response) == 0 || json.Unmarshal(response, &obj) != nil {
+		// not an object
+		return response
+	}
+	_, hasID := obj["id"]
+	if c.dryRunIDs == nil {
+		c.dryRunIDs = map[string]int{}
+	}
+	newID := func() int {
+		c.dryRunSeq++
+		return -c.dryRunSeq
+	}
+
+	if m := dryRunIssuePath.FindStringSubmatch(req.URL.Path); m != nil {
+		idOrKey := m[1]
+		switch {
+		case idOrKey == "" && req.Method == http.MethodPost:
+			id := newID()
+			key := fmt.Sprintf("DRYRUN-%d", -id)
+			c.dryRunIDs[key] = id
+			obj["id"], obj["issueKey"] = id, key
+		case idOrKey != "":
+			if id, err := strconv.Atoi(idOrKey); err == nil {
+				obj["id"] = id
+				break
+			}
+			id, ok := c.dryRunIDs[idOrKey]
+			if !ok {
+				id = newID()
+				c.dryRunIDs[idOrKey] = id
+			}
+			obj["id"], obj["issueKey"] = id, idOrKey
+		}
+	} else if !hasID && req.Method == http.MethodPost {
+		obj["id"] = newID()
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return response
+	}
+	return b
+}
+
+// multipartSummary returns the names and sizes of the parts of a multipart body,
+// and the name and size of the last file as an uploaded file
+func multipartSummary(body []byte, boundary string) (string, map[string]interface{}) {
+	mr := multipart.NewReader(bytes.NewReader(body), boundary)
+	var parts []string
+	var file map[string]interface{}
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			break
+		}
+		n, _ := io.Copy(io.Discard, part)
+		if name := part.FileName(); name != "" {
+			parts = append(parts, fmt.Sprintf("%s=@%s (%d bytes)", part.FormName(), name, n))
+			file = map[string]interface{}{"name": name, "size": n}
+		} else {
+			parts = append(parts, fmt.Sprintf("%s (%d bytes)", part.FormName(), n))
+		}
+	}
+	return "multipart: " + strings.Join(parts, ", "), file
+}
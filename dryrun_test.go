@@ -0,0 +1,161 @@
+package backlog
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	OptionDryRun(true)(client)
+	OptionLog(log.New(&buf, "", 0))(client)
+	assert.True(t, client.DryRun())
+
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := w.Write([]byte(`{"id": 1, "issueKey": "BLG-1"}`)); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/", func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+
+	issue, err := client.GetIssue("BLG-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "BLG-1", *issue.IssueKey)
+
+	issue, err = client.UpdateIssue("BLG-1", &UpdateIssueInput{Summary: String("new summary")})
+	assert.NoError(t, err)
+	assert.Equal(t, "new summary", *issue.Summary)
+
+	_, err = client.DeleteProject("TEST")
+	assert.NoError(t, err)
+
+	fpath := filepath.Join(t.TempDir(), "test.txt")
+	if err := os.WriteFile(fpath, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	file, err := client.UploadFile(fpath)
+	assert.NoError(t, err)
+	assert.Equal(t, "test.txt", *file.Name)
+
+	requests := client.DryRunRequests()
+	assert.Len(t, requests, 3)
+	assert.Equal(t, `PATCH /api/v2/issues/BLG-1 {"summary":"new summary"}`, requests[0].String())
+	assert.Equal(t, "DELETE /api/v2/projects/TEST", requests[1].String())
+	assert.Equal(t, "POST /api/v2/space/attachment multipart: file=@test.txt (5 bytes)", requests[2].String())
+	assert.Contains(t, buf.String(), "[dry-run] PATCH /api/v2/issues/BLG-1")
+	assert.NotContains(t, buf.String(), "test-token")
+}
+
+func TestReadOnly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionReadOnly(true)(client)
+	assert.True(t, client.ReadOnly())
+
+	mux.HandleFunc("/", func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+
+	_, err := client.DeleteProject("TEST")
+	var readOnly *ReadOnlyError
+	if !errors.As(err, &readOnly) {
+		t.Fatalf("expected ReadOnlyError but got %v", err)
+	}
+	assert.Equal(t, "DELETE", readOnly.Method)
+	assert.EqualError(t, err, "read-only client refused DELETE /api/v2/projects/TEST")
+
+	fpath := filepath.Join(t.TempDir(), "test.txt")
+	if err := os.WriteFile(fpath, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.UploadFile(fpath)
+	assert.True(t, errors.As(err, &readOnly))
+}
+
+func TestDryRun_CloneIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionDryRun(true)(client)
+
+	handle := func(path, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			if _, err := w.Write([]byte(body)); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	handle("/projects/NEW", `{"id": 2, "projectKey": "NEW"}`)
+	handle("/projects/NEW/statuses", `[{"id": 1, "name": "未対応"}, {"id": 2, "name": "処理中"}]`)
+	handle("/projects/NEW/issueTypes", `[{"id": 20, "name": "タスク"}]`)
+	handle("/issues/BLG-1", `{
+		"id": 1, "projectId": 1, "issueKey": "BLG-1", "summary": "parent",
+		"issueType": {"id": 10, "name": "タスク"},
+		"status": {"id": 2, "name": "処理中"},
+		"attachments": [{"id": 16, "name": "log.txt", "size": 5}]
+	}`)
+	handle("/issues/BLG-1/attachments/16", "hello")
+	handle("/issues/BLG-1/comments", `[{"id": 100, "content": "first", "createdUser": {"id": 1, "name": "admin"}, "created": "2019-01-07T10:00:00Z"}]`)
+
+	result, err := client.CloneIssue("BLG-1", &CloneIssueOptions{
+		TargetProjectIDOrKey: "NEW",
+		WithComments:         true,
+		WithAttachments:      true,
+		CloseSource:          true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, map[string]string{"BLG-1": "DRYRUN-2"}, result.KeyMap)
+	assert.Equal(t, -2, *result.Issues[0].ID)
+
+	var requests []string
+	for _, r := range client.DryRunRequests() {
+		requests = append(requests, r.Method+" "+r.Path)
+	}
+	assert.Equal(t, []string{
+		"POST /api/v2/space/attachment",
+		"POST /api/v2/issues",
+		"PATCH /api/v2/issues/DRYRUN-2",
+		"POST /api/v2/issues/DRYRUN-2/comments",
+		"PATCH /api/v2/issues/BLG-1",
+	}, requests)
+}
+
+func TestDryRun_CreateIssueIdempotent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	OptionDryRun(true)(client)
+
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := w.Write([]byte(`[]`)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	input := &CreateIssueInput{ProjectID: Int(1), Summary: String("disk full"), IssueTypeID: Int(2), PriorityID: Int(3)}
+	issue, err := client.CreateIssueIdempotent("alert-1", input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, "DRYRUN-1", *issue.IssueKey)
+
+	// the placeholder is not stored
+	_, ok, err := client.idempotencyStore.Get("1:alert-1")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -17,7 +17,8 @@ func (c *Client) UploadFile(fpath string) (*FileUploadResponse, error) {
 }
 
 // UploadFileContext uploads a file and setting a custom context
-func (c *Client) UploadFileContext(ctx context.Context, fpath string) (*FileUploadResponse, error) {
+func (c *Client) UploadFileContext(ctx context.Context, fpath string, callOpts ...CallOption) (*FileUploadResponse, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/space/attachment"
 
 	fileUploadResponse := new(FileUploadResponse)
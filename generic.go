@@ -0,0 +1,67 @@
+package backlog
+
+import (
+	"context"
+	"strings"
+)
+
+// The generic functions below call endpoints which this package does not wrap
+// yet, in the same way as the methods of Client.
+//
+// path is relative to "/api/v2" unless it starts with "/api/", opts is encoded
+// as URL query parameters by its "url" tags, and body is encoded as JSON.
+// callOpts apply as to the ...Context methods.
+//
+// ex.
+//
+//	documents, err := backlog.Get[[]*MyDocument](ctx, client, "/documents", &MyDocumentsOptions{ProjectIDs: []int{1}})
+
+// Get sends a GET request and returns the decoded response
+func Get[T any](ctx context.Context, c *Client, path string, opts interface{}, callOpts ...CallOption) (T, error) {
+	return send[T](withCallOptions(ctx, callOpts), c, "GET", path, opts, nil)
+}
+
+// Post sends a POST request and returns the decoded response
+func Post[T any](ctx context.Context, c *Client, path string, body interface{}, callOpts ...CallOption) (T, error) {
+	return send[T](withCallOptions(ctx, callOpts), c, "POST", path, nil, body)
+}
+
+// Patch sends a PATCH request and returns the decoded response
+func Patch[T any](ctx context.Context, c *Client, path string, body interface{}, callOpts ...CallOption) (T, error) {
+	return send[T](withCallOptions(ctx, callOpts), c, "PATCH", path, nil, body)
+}
+
+// Put sends a PUT request and returns the decoded response
+func Put[T any](ctx context.Context, c *Client, path string, body interface{}, callOpts ...CallOption) (T, error) {
+	return send[T](withCallOptions(ctx, callOpts), c, "PUT", path, nil, body)
+}
+
+// Delete sends a DELETE request and returns the decoded response
+func Delete[T any](ctx context.Context, c *Client, path string, body interface{}, callOpts ...CallOption) (T, error) {
+	return send[T](withCallOptions(ctx, callOpts), c, "DELETE", path, nil, body)
+}
+
+func send[T any](ctx context.Context, c *Client, method, path string, opts, body interface{}) (T, error) {
+	var result T
+	if !strings.HasPrefix(path, "/api/") {
+		path = "/api/v2" + path
+	}
+
+	u := path
+	if opts != nil {
+		var err error
+		if u, err = c.AddOptions(path, opts); err != nil {
+			return result, err
+		}
+	}
+
+	req, err := c.NewRequest(method, u, body)
+	if err != nil {
+		return result, err
+	}
+
+	if err := c.Do(ctx, req, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
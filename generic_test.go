@@ -0,0 +1,89 @@
+package backlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testDocument struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type testDocumentsOptions struct {
+	ProjectIDs []int `url:"projectId[],omitempty"`
+	Count      *int  `url:"count,omitempty"`
+}
+
+func TestGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, []string{"1"}, r.URL.Query()["projectId[]"])
+		assert.Equal(t, "test-token", r.URL.Query().Get("apiKey"))
+		if _, err := fmt.Fprint(w, `[{"id": "abc", "title": "doc"}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	documents, err := Get[[]*testDocument](context.Background(), client, "/documents", &testDocumentsOptions{ProjectIDs: []int{1}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, []*testDocument{{ID: "abc", Title: "doc"}}, documents)
+}
+
+func TestPostPatchPutDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents/abc", func(w http.ResponseWriter, r *http.Request) {
+		var input map[string]string
+		if r.Method != "DELETE" {
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := fmt.Fprintf(w, `{"id": "abc", "title": "%s %s"}`, r.Method, input["title"]); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	ctx := context.Background()
+	body := map[string]string{"title": "doc"}
+	doc, err := Post[testDocument](ctx, client, "/api/v2/documents/abc", body)
+	assert.NoError(t, err)
+	assert.Equal(t, "POST doc", doc.Title)
+
+	doc, err = Patch[testDocument](ctx, client, "/documents/abc", body)
+	assert.NoError(t, err)
+	assert.Equal(t, "PATCH doc", doc.Title)
+
+	doc, err = Put[testDocument](ctx, client, "/documents/abc", body)
+	assert.NoError(t, err)
+	assert.Equal(t, "PUT doc", doc.Title)
+
+	doc, err = Delete[testDocument](ctx, client, "/documents/abc", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE ", doc.Title)
+}
+
+func TestGetFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/documents", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := Get[[]*testDocument](context.Background(), client, "/documents", nil); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
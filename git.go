@@ -31,7 +31,8 @@ func (c *Client) GetGitRepositories(projectIDOrKey interface{}) (*ResponseGitRep
 }
 
 // GetGitRepositoriesContext returns git repositories
-func (c *Client) GetGitRepositoriesContext(ctx context.Context, projectIDOrKey interface{}) (*ResponseGitRepositories, error) {
+func (c *Client) GetGitRepositoriesContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) (*ResponseGitRepositories, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -53,7 +54,8 @@ func (c *Client) GetGitRepository(projectIDOrKey interface{}, repoIDOrName inter
 }
 
 // GetGitRepositoryContext returns git repository
-func (c *Client) GetGitRepositoryContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}) (*GitRepository, error) {
+func (c *Client) GetGitRepositoryContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, callOpts ...CallOption) (*GitRepository, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v", projectIDOrKey, repoIDOrName)
 
 	req, err := c.NewRequest("GET", u, nil)
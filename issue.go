@@ -109,6 +109,30 @@ type Issue struct {
 	Stars          []*Star             `json:"stars,omitempty"`
 }
 
+// IssueField : field of an issue
+type IssueField string
+
+// IssueField is named after the json key of Issue
+const (
+	IssueFieldSummary        = IssueField("summary")
+	IssueFieldDescription    = IssueField("description")
+	IssueFieldIssueType      = IssueField("issueType")
+	IssueFieldStatus         = IssueField("status")
+	IssueFieldResolution     = IssueField("resolution")
+	IssueFieldPriority       = IssueField("priority")
+	IssueFieldAssignee       = IssueField("assignee")
+	IssueFieldCategory       = IssueField("category")
+	IssueFieldVersions       = IssueField("versions")
+	IssueFieldMilestone      = IssueField("milestone")
+	IssueFieldStartDate      = IssueField("startDate")
+	IssueFieldDueDate        = IssueField("dueDate")
+	IssueFieldEstimatedHours = IssueField("estimatedHours")
+	IssueFieldActualHours    = IssueField("actualHours")
+	IssueFieldParentIssueID  = IssueField("parentIssueId")
+	IssueFieldCustomFields   = IssueField("customFields")
+	IssueFieldAttachments    = IssueField("attachments")
+)
+
 // Milestone : milestone
 type Milestone struct {
 	ID             *int    `json:"id,omitempty"`
@@ -173,7 +197,8 @@ func (c *Client) GetIssues(opts *GetIssuesOptions) ([]*Issue, error) {
 }
 
 // GetIssuesContext returns the list of issues with context
-func (c *Client) GetIssuesContext(ctx context.Context, opts *GetIssuesOptions) ([]*Issue, error) {
+func (c *Client) GetIssuesContext(ctx context.Context, opts *GetIssuesOptions, callOpts ...CallOption) ([]*Issue, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u, err := c.AddOptions("/api/v2/issues", opts)
 	if err != nil {
 		return nil, err
@@ -191,6 +216,33 @@ func (c *Client) GetIssuesContext(ctx context.Context, opts *GetIssuesOptions) (
 	return issues, nil
 }
 
+// getAllIssuesContext returns all issues matching opts, following offsets page by page
+func (c *Client) getAllIssuesContext(ctx context.Context, opts *GetIssuesOptions) ([]*Issue, error) {
+	o := GetIssuesOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	offset := 0
+	if o.Offset != nil {
+		offset = *o.Offset
+	}
+	o.Count = Int(maxIssueQueryCount)
+
+	all := []*Issue{}
+	for {
+		o.Offset = Int(offset)
+		issues, err := c.GetIssuesContext(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, issues...)
+		if len(issues) < maxIssueQueryCount {
+			return all, nil
+		}
+		offset += len(issues)
+	}
+}
+
 // Issues : list of issue
 type Issues []*struct {
 	Issue *Issue `json:"issue"`
@@ -220,7 +272,8 @@ func (c *Client) GetUserMySelfRecentrlyViewedIssues(opts *GetUserMySelfRecentrly
 }
 
 // GetUserMySelfRecentrlyViewedIssuesContext returns the list of issues a user view recently with context
-func (c *Client) GetUserMySelfRecentrlyViewedIssuesContext(ctx context.Context, opts *GetUserMySelfRecentrlyViewedIssuesOptions) (Issues, error) {
+func (c *Client) GetUserMySelfRecentrlyViewedIssuesContext(ctx context.Context, opts *GetUserMySelfRecentrlyViewedIssuesOptions, callOpts ...CallOption) (Issues, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/users/myself/recentlyViewedIssues"
 
 	u, err := c.AddOptions(u, opts)
@@ -247,7 +300,8 @@ func (c *Client) GetIssueCount(opts *GetIssuesCountOptions) (int, error) {
 }
 
 // GetIssueCountContext returns the count of issues with context
-func (c *Client) GetIssueCountContext(ctx context.Context, opts *GetIssuesCountOptions) (int, error) {
+func (c *Client) GetIssueCountContext(ctx context.Context, opts *GetIssuesCountOptions, callOpts ...CallOption) (int, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/issues/count"
 
 	u, err := c.AddOptions(u, opts)
@@ -301,7 +355,8 @@ func (c *Client) CreateIssue(input *CreateIssueInput) (*Issue, error) {
 }
 
 // CreateIssueContext creates a issue with context
-func (c *Client) CreateIssueContext(ctx context.Context, input *CreateIssueInput) (*Issue, error) {
+func (c *Client) CreateIssueContext(ctx context.Context, input *CreateIssueInput, callOpts ...CallOption) (*Issue, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/issues"
 
 	if q := createQueryStringsFromIssueCustomFields(input.CustomFields); q != "" {
@@ -326,7 +381,8 @@ func (c *Client) GetIssue(issueIDOrKey string) (*Issue, error) {
 }
 
 // GetIssueContext gets a issue with context
-func (c *Client) GetIssueContext(ctx context.Context, issueIDOrKey string) (*Issue, error) {
+func (c *Client) GetIssueContext(ctx context.Context, issueIDOrKey string, callOpts ...CallOption) (*Issue, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v", issueIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -347,7 +403,8 @@ func (c *Client) UpdateIssue(issueIDOrKey string, input *UpdateIssueInput) (*Iss
 }
 
 // UpdateIssueContext updates a issue with context
-func (c *Client) UpdateIssueContext(ctx context.Context, issueIDOrKey string, input *UpdateIssueInput) (*Issue, error) {
+func (c *Client) UpdateIssueContext(ctx context.Context, issueIDOrKey string, input *UpdateIssueInput, callOpts ...CallOption) (*Issue, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v", issueIDOrKey)
 
 	if q := createQueryStringsFromIssueCustomFields(input.CustomFields); q != "" {
@@ -372,7 +429,8 @@ func (c *Client) DeleteIssue(issueIDOrKey string) (*Issue, error) {
 }
 
 // DeleteIssueContext deletes an issue with context
-func (c *Client) DeleteIssueContext(ctx context.Context, issueIDOrKey string) (*Issue, error) {
+func (c *Client) DeleteIssueContext(ctx context.Context, issueIDOrKey string, callOpts ...CallOption) (*Issue, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v", issueIDOrKey)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -393,10 +451,16 @@ func (c *Client) GetIssueComments(issueIDOrKey string, opts *GetIssueCommentsOpt
 }
 
 // GetIssueCommentsContext gets list of the issue comments with context
-func (c *Client) GetIssueCommentsContext(ctx context.Context, issueIDOrKey string, opts *GetIssueCommentsOptions) ([]*IssueComment, error) {
+func (c *Client) GetIssueCommentsContext(ctx context.Context, issueIDOrKey string, opts *GetIssueCommentsOptions, callOpts ...CallOption) ([]*IssueComment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/comments", issueIDOrKey)
 
-	req, err := c.NewRequest("GET", u, opts)
+	u, err := c.AddOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -408,13 +472,41 @@ func (c *Client) GetIssueCommentsContext(ctx context.Context, issueIDOrKey strin
 	return issueComment, nil
 }
 
+// getAllIssueCommentsContext returns all comments of an issue in ascending order
+func (c *Client) getAllIssueCommentsContext(ctx context.Context, issueIDOrKey string) ([]*IssueComment, error) {
+	const count = 100
+
+	all := []*IssueComment{}
+	opts := &GetIssueCommentsOptions{Count: Int(count), Order: OrderAsc}
+	for {
+		comments, err := c.GetIssueCommentsContext(ctx, issueIDOrKey, opts)
+		if err != nil {
+			return nil, err
+		}
+		added := 0
+		for _, comment := range comments {
+			// skip the comment of minId in case it is inclusive
+			if opts.MinID != nil && *comment.ID <= *opts.MinID {
+				continue
+			}
+			all = append(all, comment)
+			added++
+		}
+		if len(comments) < count || added == 0 {
+			return all, nil
+		}
+		opts.MinID = all[len(all)-1].ID
+	}
+}
+
 // CreateIssueComment creates a issue comments
 func (c *Client) CreateIssueComment(issueIDOrKey string, input *CreateIssueCommentInput) (*IssueComment, error) {
 	return c.CreateIssueCommentContext(context.Background(), issueIDOrKey, input)
 }
 
 // CreateIssueCommentContext creates a issue comments with context
-func (c *Client) CreateIssueCommentContext(ctx context.Context, issueIDOrKey string, input *CreateIssueCommentInput) (*IssueComment, error) {
+func (c *Client) CreateIssueCommentContext(ctx context.Context, issueIDOrKey string, input *CreateIssueCommentInput, callOpts ...CallOption) (*IssueComment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/comments", issueIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -435,7 +527,8 @@ func (c *Client) GetIssueCommentsCount(issueIDOrKey string) (int, error) {
 }
 
 // GetIssueCommentsCountContext gets count of issue comments with context
-func (c *Client) GetIssueCommentsCountContext(ctx context.Context, issueIDOrKey string) (int, error) {
+func (c *Client) GetIssueCommentsCountContext(ctx context.Context, issueIDOrKey string, callOpts ...CallOption) (int, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/comments/count", issueIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -456,7 +549,8 @@ func (c *Client) GetIssueComment(issueIDOrKey string, commentID int) (*IssueComm
 }
 
 // GetIssueCommentContext gets a issue comment with context
-func (c *Client) GetIssueCommentContext(ctx context.Context, issueIDOrKey string, commentID int) (*IssueComment, error) {
+func (c *Client) GetIssueCommentContext(ctx context.Context, issueIDOrKey string, commentID int, callOpts ...CallOption) (*IssueComment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/comments/%v", issueIDOrKey, commentID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -477,7 +571,8 @@ func (c *Client) DeleteIssueComment(issueIDOrKey string, commentID int) (*IssueC
 }
 
 // DeleteIssueCommentContext deletes a issue comment with context
-func (c *Client) DeleteIssueCommentContext(ctx context.Context, issueIDOrKey string, commentID int) (*IssueComment, error) {
+func (c *Client) DeleteIssueCommentContext(ctx context.Context, issueIDOrKey string, commentID int, callOpts ...CallOption) (*IssueComment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/comments/%v", issueIDOrKey, commentID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -498,7 +593,8 @@ func (c *Client) UpdateIssueComment(issueIDOrKey string, commentID int, input *U
 }
 
 // UpdateIssueCommentContext updates a issue comment with context
-func (c *Client) UpdateIssueCommentContext(ctx context.Context, issueIDOrKey string, commentID int, input *UpdateIssueCommentInput) (*IssueComment, error) {
+func (c *Client) UpdateIssueCommentContext(ctx context.Context, issueIDOrKey string, commentID int, input *UpdateIssueCommentInput, callOpts ...CallOption) (*IssueComment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/comments/%v", issueIDOrKey, commentID)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -519,7 +615,8 @@ func (c *Client) GetIssueCommentsNotifications(issueIDOrKey string, commentID in
 }
 
 // GetIssueCommentsNotificationsContext gets a issue comment with context
-func (c *Client) GetIssueCommentsNotificationsContext(ctx context.Context, issueIDOrKey string, commentID int) ([]*Notification, error) {
+func (c *Client) GetIssueCommentsNotificationsContext(ctx context.Context, issueIDOrKey string, commentID int, callOpts ...CallOption) ([]*Notification, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/comments/%v/notifications", issueIDOrKey, commentID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -540,7 +637,8 @@ func (c *Client) CreateIssueCommentsNotification(issueIDOrKey string, commentID
 }
 
 // CreateIssueCommentsNotificationContext creates a notification with context
-func (c *Client) CreateIssueCommentsNotificationContext(ctx context.Context, issueIDOrKey string, commentID int, input *CreateIssueCommentsNotificationInput) (*IssueComment, error) {
+func (c *Client) CreateIssueCommentsNotificationContext(ctx context.Context, issueIDOrKey string, commentID int, input *CreateIssueCommentsNotificationInput, callOpts ...CallOption) (*IssueComment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/comments/%v/notifications", issueIDOrKey, commentID)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -561,7 +659,8 @@ func (c *Client) GetIssueAttachments(issueIDOrKey string) ([]*Attachment, error)
 }
 
 // GetIssueAttachmentsContext gets issue attachments with context
-func (c *Client) GetIssueAttachmentsContext(ctx context.Context, issueIDOrKey string) ([]*Attachment, error) {
+func (c *Client) GetIssueAttachmentsContext(ctx context.Context, issueIDOrKey string, callOpts ...CallOption) ([]*Attachment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/attachments", issueIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -582,7 +681,8 @@ func (c *Client) GetIssueAttachment(issueIDOrKey string, attachmentID int, write
 }
 
 // GetIssueAttachmentContext downloads an issue attachment with context
-func (c *Client) GetIssueAttachmentContext(ctx context.Context, issueIDOrKey string, attachmentID int, writer io.Writer) error {
+func (c *Client) GetIssueAttachmentContext(ctx context.Context, issueIDOrKey string, attachmentID int, writer io.Writer, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/attachments/%v", issueIDOrKey, attachmentID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -602,7 +702,8 @@ func (c *Client) DeleteIssueAttachment(issueIDOrKey string, attachmentID int) (*
 }
 
 // DeleteIssueAttachmentContext deletes an issue attachments with context
-func (c *Client) DeleteIssueAttachmentContext(ctx context.Context, issueIDOrKey string, attachmentID int) (*Attachment, error) {
+func (c *Client) DeleteIssueAttachmentContext(ctx context.Context, issueIDOrKey string, attachmentID int, callOpts ...CallOption) (*Attachment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/attachments/%v", issueIDOrKey, attachmentID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -623,7 +724,8 @@ func (c *Client) GetIssueParticipants(issueIDOrKey string) ([]*User, error) {
 }
 
 // GetIssueParticipantsContext gets participants of a issue with context
-func (c *Client) GetIssueParticipantsContext(ctx context.Context, issueIDOrKey string) ([]*User, error) {
+func (c *Client) GetIssueParticipantsContext(ctx context.Context, issueIDOrKey string, callOpts ...CallOption) ([]*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/participants", issueIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -644,7 +746,8 @@ func (c *Client) GetIssueSharedFiles(issueIDOrKey string) ([]*SharedFile, error)
 }
 
 // GetIssueSharedFilesContext gets shared files of a issue with context
-func (c *Client) GetIssueSharedFilesContext(ctx context.Context, issueIDOrKey string) ([]*SharedFile, error) {
+func (c *Client) GetIssueSharedFilesContext(ctx context.Context, issueIDOrKey string, callOpts ...CallOption) ([]*SharedFile, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/sharedFiles", issueIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -665,7 +768,8 @@ func (c *Client) CreateIssueSharedFiles(issueIDOrKey string, input *CreateIssueS
 }
 
 // CreateIssueSharedFilesContext link a shared files to a issue with context
-func (c *Client) CreateIssueSharedFilesContext(ctx context.Context, issueIDOrKey string, input *CreateIssueSharedFilesInput) ([]*SharedFile, error) {
+func (c *Client) CreateIssueSharedFilesContext(ctx context.Context, issueIDOrKey string, input *CreateIssueSharedFilesInput, callOpts ...CallOption) ([]*SharedFile, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/sharedFiles", issueIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -686,7 +790,8 @@ func (c *Client) DeleteIssueSharedFile(issueIDOrKey string, sharedFileID int) (*
 }
 
 // DeleteIssueSharedFileContext link a shared files to a issue with context
-func (c *Client) DeleteIssueSharedFileContext(ctx context.Context, issueIDOrKey string, sharedFileID int) (*SharedFile, error) {
+func (c *Client) DeleteIssueSharedFileContext(ctx context.Context, issueIDOrKey string, sharedFileID int, callOpts ...CallOption) (*SharedFile, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/issues/%v/sharedFiles/%v", issueIDOrKey, sharedFileID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -830,10 +935,10 @@ type UpdateIssueInput struct {
 
 // GetIssueCommentsOptions specifies parameters to the GetIssueComments method.
 type GetIssueCommentsOptions struct {
-	MinID *int  `json:"minId,omitempty"`
-	MaxID *int  `json:"maxId,omitempty"`
-	Count *int  `json:"count,omitempty"`
-	Order Order `json:"order,omitempty"`
+	MinID *int  `url:"minId,omitempty"`
+	MaxID *int  `url:"maxId,omitempty"`
+	Count *int  `url:"count,omitempty"`
+	Order Order `url:"order,omitempty"`
 }
 
 // CreateIssueCommentInput specifies parameters to the CreateIssueComment method.
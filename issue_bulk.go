@@ -0,0 +1,377 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// defaultBulkUpdateConcurrency is the number of issues updated at once by default
+const defaultBulkUpdateConcurrency = 4
+
+// BulkUpdateIssuesInput specifies parameters to the PreviewBulkUpdateIssues method.
+//
+// Target issues are selected either by Selector or by IssueKeys.
+type BulkUpdateIssuesInput struct {
+	Selector  *GetIssuesOptions
+	IssueKeys []string
+	Update    *UpdateIssueInput
+}
+
+// BulkUpdateChange : a field change which a bulk update makes to an issue.
+// IDs are shown as is, and multiple IDs are joined by commas.
+type BulkUpdateChange struct {
+	Field IssueField
+	Name  string // the custom field name if Field is IssueFieldCustomFields
+	From  string
+	To    string
+}
+
+// BulkUpdatePreviewItem : an issue to be updated and its changes
+type BulkUpdatePreviewItem struct {
+	Issue   *Issue
+	Changes []*BulkUpdateChange
+}
+
+// BulkUpdatePreview : the preview of a bulk update
+type BulkUpdatePreview struct {
+	Update *UpdateIssueInput
+	Items  []*BulkUpdatePreviewItem
+}
+
+// ApplyBulkUpdateOptions specifies parameters to the ApplyBulkUpdateIssues method.
+type ApplyBulkUpdateOptions struct {
+	// Concurrency is the number of issues updated at once. The default is 4.
+	Concurrency int
+	// Comment overrides the comment of the update
+	Comment *string
+	// NotifiedUserIDs overrides the users notified of the update
+	NotifiedUserIDs []int
+	// SkipUnchanged skips issues which have no field changes
+	SkipUnchanged bool
+}
+
+// BulkUpdateResult : the result of updating an issue
+type BulkUpdateResult struct {
+	IssueKey string
+	Issue    *Issue
+	Err      error
+}
+
+// BulkUpdateReport : the results of a bulk update in the order of the preview
+type BulkUpdateReport struct {
+	Results []*BulkUpdateResult
+}
+
+// Succeeded returns the results of the updated issues
+func (r *BulkUpdateReport) Succeeded() []*BulkUpdateResult {
+	var results []*BulkUpdateResult
+	for _, res := range r.Results {
+		if res.Err == nil {
+			results = append(results, res)
+		}
+	}
+	return results
+}
+
+// Failed returns the results of the issues failed to update
+func (r *BulkUpdateReport) Failed() []*BulkUpdateResult {
+	var results []*BulkUpdateResult
+	for _, res := range r.Results {
+		if res.Err != nil {
+			results = append(results, res)
+		}
+	}
+	return results
+}
+
+// PreviewBulkUpdateIssues returns the issues and the field changes a bulk update will make
+func (c *Client) PreviewBulkUpdateIssues(input *BulkUpdateIssuesInput) (*BulkUpdatePreview, error) {
+	return c.PreviewBulkUpdateIssuesContext(context.Background(), input)
+}
+
+// PreviewBulkUpdateIssuesContext returns the issues and the field changes a bulk update will make with context
+func (c *Client) PreviewBulkUpdateIssuesContext(ctx context.Context, input *BulkUpdateIssuesInput, callOpts ...CallOption) (*BulkUpdatePreview, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	if input.Update == nil {
+		return nil, errors.New("update must be specified")
+	}
+	if (input.Selector == nil) == (len(input.IssueKeys) == 0) {
+		return nil, errors.New("either selector or issue keys must be specified")
+	}
+
+	var issues []*Issue
+	if input.Selector != nil {
+		all, err := c.getAllIssuesContext(ctx, input.Selector)
+		if err != nil {
+			return nil, err
+		}
+		issues = all
+	} else {
+		for _, key := range input.IssueKeys {
+			issue, err := c.GetIssueContext(ctx, key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get issue %s", key)
+			}
+			issues = append(issues, issue)
+		}
+	}
+
+	preview := &BulkUpdatePreview{Update: input.Update}
+	for _, issue := range issues {
+		preview.Items = append(preview.Items, &BulkUpdatePreviewItem{
+			Issue:   issue,
+			Changes: updateIssueChanges(issue, input.Update),
+		})
+	}
+	return preview, nil
+}
+
+// ApplyBulkUpdateIssues updates the issues in a preview
+func (c *Client) ApplyBulkUpdateIssues(preview *BulkUpdatePreview, opts *ApplyBulkUpdateOptions) (*BulkUpdateReport, error) {
+	return c.ApplyBulkUpdateIssuesContext(context.Background(), preview, opts)
+}
+
+// ApplyBulkUpdateIssuesContext updates the issues in a preview with context.
+// Failures of each issue are reported in BulkUpdateReport; the returned error
+// is non-nil only when ctx is done before all issues are processed.
+func (c *Client) ApplyBulkUpdateIssuesContext(ctx context.Context, preview *BulkUpdatePreview, opts *ApplyBulkUpdateOptions, callOpts ...CallOption) (*BulkUpdateReport, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	if opts == nil {
+		opts = &ApplyBulkUpdateOptions{}
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBulkUpdateConcurrency
+	}
+
+	input := *preview.Update
+	if opts.Comment != nil {
+		input.Comment = opts.Comment
+	}
+	if opts.NotifiedUserIDs != nil {
+		input.NotifiedUserIDs = opts.NotifiedUserIDs
+	}
+
+	report := &BulkUpdateReport{}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, item := range preview.Items {
+		if opts.SkipUnchanged && len(item.Changes) == 0 {
+			continue
+		}
+
+		res := &BulkUpdateResult{IssueKey: derefString(item.Issue.IssueKey)}
+		report.Results = append(report.Results, res)
+
+		select {
+		case <-ctx.Done():
+			res.Err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(res *BulkUpdateResult) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			in := input
+			res.Issue, res.Err = c.UpdateIssueContext(ctx, res.IssueKey, &in)
+		}(res)
+	}
+	wg.Wait()
+
+	return report, ctx.Err()
+}
+
+// updateIssueChanges returns the changes input makes to issue
+func updateIssueChanges(issue *Issue, input *UpdateIssueInput) []*BulkUpdateChange {
+	var changes []*BulkUpdateChange
+	add := func(field IssueField, name, from, to string) {
+		if from != to {
+			changes = append(changes, &BulkUpdateChange{Field: field, Name: name, From: from, To: to})
+		}
+	}
+
+	if input.Summary != nil {
+		add(IssueFieldSummary, "", derefString(issue.Summary), *input.Summary)
+	}
+	if input.Description != nil {
+		add(IssueFieldDescription, "", derefString(issue.Description), *input.Description)
+	}
+	if input.IssueTypeID != nil {
+		var from *int
+		if issue.IssueType != nil {
+			from = issue.IssueType.ID
+		}
+		add(IssueFieldIssueType, "", intPtrString(from), strconv.Itoa(*input.IssueTypeID))
+	}
+	if input.StatusID != nil {
+		var from *int
+		if issue.Status != nil {
+			from = issue.Status.ID
+		}
+		add(IssueFieldStatus, "", intPtrString(from), strconv.Itoa(*input.StatusID))
+	}
+	if input.ResolutionID != nil {
+		var from *int
+		if issue.Resolution != nil {
+			from = issue.Resolution.ID
+		}
+		add(IssueFieldResolution, "", intPtrString(from), valueString(input.ResolutionID))
+	}
+	if input.PriorityID != nil {
+		var from *int
+		if issue.Priority != nil {
+			from = issue.Priority.ID
+		}
+		add(IssueFieldPriority, "", intPtrString(from), strconv.Itoa(*input.PriorityID))
+	}
+	if input.AssigneeID != nil {
+		var from *int
+		if issue.Assignee != nil {
+			from = issue.Assignee.ID
+		}
+		add(IssueFieldAssignee, "", intPtrString(from), valueString(input.AssigneeID))
+	}
+	if len(input.CategoryIDs) > 0 {
+		var from []int
+		for _, v := range issue.Category {
+			from = append(from, *v.ID)
+		}
+		add(IssueFieldCategory, "", idsString(from), idsString(input.CategoryIDs))
+	}
+	if len(input.VersionIDs) > 0 {
+		var from []int
+		for _, v := range issue.Versions {
+			from = append(from, *v.ID)
+		}
+		add(IssueFieldVersions, "", idsString(from), idsString(input.VersionIDs))
+	}
+	if len(input.MilestoneIDs) > 0 {
+		var from []int
+		for _, v := range issue.Milestone {
+			from = append(from, *v.ID)
+		}
+		add(IssueFieldMilestone, "", idsString(from), idsString(input.MilestoneIDs))
+	}
+	if input.StartDate != nil {
+		add(IssueFieldStartDate, "", issueDate(issue.StartDate), *input.StartDate)
+	}
+	if input.DueDate != nil {
+		add(IssueFieldDueDate, "", issueDate(issue.DueDate), *input.DueDate)
+	}
+	if input.EstimatedHours != nil {
+		add(IssueFieldEstimatedHours, "", floatPtrString(issue.EstimatedHours), valueString(input.EstimatedHours))
+	}
+	if input.ActualHours != nil {
+		add(IssueFieldActualHours, "", floatPtrString(issue.ActualHours), valueString(input.ActualHours))
+	}
+	if input.ParentIssueID != nil {
+		add(IssueFieldParentIssueID, "", intPtrString(issue.ParentIssueID), strconv.Itoa(*input.ParentIssueID))
+	}
+	for _, cf := range input.CustomFields {
+		from := ""
+		for _, current := range issue.CustomFields {
+			if current.ID != nil && cf.ID != nil && *current.ID == *cf.ID {
+				from = customFieldValueString(current.Value)
+			}
+		}
+		add(IssueFieldCustomFields, derefString(cf.Name), from, customFieldValueString(cf.Value))
+	}
+	return changes
+}
+
+func intPtrString(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
+
+func floatPtrString(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+// valueString formats a value of UpdateIssueInput which may be a pointer
+func valueString(v interface{}) string {
+	switch value := v.(type) {
+	case *int:
+		return intPtrString(value)
+	case *float64:
+		return floatPtrString(value)
+	case *string:
+		return derefString(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// idsString returns sorted IDs joined by commas
+func idsString(ids []int) string {
+	sorted := append([]int{}, ids...)
+	sort.Ints(sorted)
+	s := make([]string, 0, len(sorted))
+	for _, id := range sorted {
+		s = append(s, strconv.Itoa(id))
+	}
+	return strings.Join(s, ",")
+}
+
+// issueDate trims the time of a date of an issue such as "2019-01-07T00:00:00Z"
+func issueDate(s *string) string {
+	if s == nil {
+		return ""
+	}
+	if len(*s) > len(issueQueryDateLayout) {
+		return (*s)[:len(issueQueryDateLayout)]
+	}
+	return *s
+}
+
+// customFieldValueString formats the value of a custom field.
+// List items are shown by their IDs.
+func customFieldValueString(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case []*Item:
+		var ids []int
+		for _, item := range value {
+			ids = append(ids, *item.ID)
+		}
+		return idsString(ids)
+	case *Item:
+		return intPtrString(value.ID)
+	case []interface{}:
+		// list items decoded from a response
+		var ids []int
+		for _, item := range value {
+			if m, ok := item.(map[string]interface{}); ok {
+				if id, ok := m["id"].(float64); ok {
+					ids = append(ids, int(id))
+				}
+			}
+		}
+		return idsString(ids)
+	case map[string]interface{}:
+		if id, ok := value["id"].(float64); ok {
+			return strconv.Itoa(int(id))
+		}
+		return fmt.Sprint(value)
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,193 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testJSONBulkIssue(id int, key string, statusID int) string {
+	return fmt.Sprintf(`{
+		"id": %d,
+		"projectId": 1,
+		"issueKey": "%s",
+		"summary": "issue %d",
+		"status": {"id": %d, "name": "status"},
+		"milestone": [{"id": 30, "name": "Sprint 1"}],
+		"dueDate": "2019-01-07T00:00:00Z",
+		"customFields": [
+			{"id": 41, "fieldTypeId": 6, "name": "OS", "value": [{"id": 1, "name": "Windows"}]}
+		]
+	}`, id, key, id, statusID)
+}
+
+func TestPreviewBulkUpdateIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "3", r.URL.Query().Get("categoryId[]"))
+		assert.Equal(t, "100", r.URL.Query().Get("count"))
+
+		var issues []string
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			for i := 1; i <= 100; i++ {
+				issues = append(issues, testJSONBulkIssue(i, fmt.Sprintf("BLG-%d", i), 1))
+			}
+		case "100":
+			issues = append(issues, testJSONBulkIssue(101, "BLG-101", 4))
+		default:
+			t.Fatalf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+		if _, err := fmt.Fprintf(w, "[%s]", strings.Join(issues, ",")); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	preview, err := client.PreviewBulkUpdateIssues(&BulkUpdateIssuesInput{
+		Selector: &GetIssuesOptions{CategoryIDs: []int{3}},
+		Update: &UpdateIssueInput{
+			StatusID:     Int(4),
+			MilestoneIDs: []int{31},
+			DueDate:      String("2019-01-07"),
+			CustomFields: []*IssueCustomField{
+				{ID: Int(41), Name: String("OS"), Value: []*Item{{ID: Int(2)}}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Len(t, preview.Items, 101)
+	assert.Equal(t, []*BulkUpdateChange{
+		{Field: IssueFieldStatus, From: "1", To: "4"},
+		{Field: IssueFieldMilestone, From: "30", To: "31"},
+		{Field: IssueFieldCustomFields, Name: "OS", From: "1", To: "2"},
+	}, preview.Items[0].Changes)
+	assert.Equal(t, []*BulkUpdateChange{
+		{Field: IssueFieldMilestone, From: "30", To: "31"},
+		{Field: IssueFieldCustomFields, Name: "OS", From: "1", To: "2"},
+	}, preview.Items[100].Changes)
+}
+
+func TestPreviewBulkUpdateIssuesByKeys(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, testJSONBulkIssue(1, "BLG-1", 1)); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/issues/BLG-2", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	preview, err := client.PreviewBulkUpdateIssues(&BulkUpdateIssuesInput{
+		IssueKeys: []string{"BLG-1"},
+		Update:    &UpdateIssueInput{Summary: String("issue 1")},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Len(t, preview.Items, 1)
+	assert.Empty(t, preview.Items[0].Changes)
+
+	_, err = client.PreviewBulkUpdateIssues(&BulkUpdateIssuesInput{
+		IssueKeys: []string{"BLG-1", "BLG-2"},
+		Update:    &UpdateIssueInput{Summary: String("issue 1")},
+	})
+	assert.Error(t, err)
+}
+
+func TestPreviewBulkUpdateIssuesInvalidInput(t *testing.T) {
+	client := New("test-token", "https://example.com")
+
+	_, err := client.PreviewBulkUpdateIssues(&BulkUpdateIssuesInput{IssueKeys: []string{"BLG-1"}})
+	assert.Error(t, err)
+
+	_, err = client.PreviewBulkUpdateIssues(&BulkUpdateIssuesInput{Update: &UpdateIssueInput{}})
+	assert.Error(t, err)
+
+	_, err = client.PreviewBulkUpdateIssues(&BulkUpdateIssuesInput{
+		Selector:  &GetIssuesOptions{},
+		IssueKeys: []string{"BLG-1"},
+		Update:    &UpdateIssueInput{},
+	})
+	assert.Error(t, err)
+}
+
+func TestApplyBulkUpdateIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var running, maxRunning int32
+	handler := func(id int, key string, fail bool) {
+		mux.HandleFunc("/issues/"+key, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PATCH")
+
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+
+			var input map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "moved", input["comment"])
+			assert.Equal(t, []interface{}{float64(2)}, input["notifiedUserId"])
+
+			if fail {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if _, err := fmt.Fprint(w, testJSONBulkIssue(id, key, 4)); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	preview := &BulkUpdatePreview{Update: &UpdateIssueInput{StatusID: Int(4), Comment: String("template")}}
+	for i := 1; i <= 5; i++ {
+		key := fmt.Sprintf("BLG-%d", i)
+		handler(i, key, i == 3)
+		preview.Items = append(preview.Items, &BulkUpdatePreviewItem{
+			Issue:   &Issue{ID: Int(i), IssueKey: String(key)},
+			Changes: []*BulkUpdateChange{{Field: IssueFieldStatus, From: "1", To: "4"}},
+		})
+	}
+	preview.Items = append(preview.Items, &BulkUpdatePreviewItem{
+		Issue: &Issue{ID: Int(6), IssueKey: String("BLG-6")},
+	})
+
+	report, err := client.ApplyBulkUpdateIssues(preview, &ApplyBulkUpdateOptions{
+		Concurrency:     2,
+		Comment:         String("moved"),
+		NotifiedUserIDs: []int{2},
+		SkipUnchanged:   true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Len(t, report.Results, 5)
+	assert.Len(t, report.Succeeded(), 4)
+	assert.Len(t, report.Failed(), 1)
+	assert.Equal(t, "BLG-3", report.Failed()[0].IssueKey)
+	assert.Equal(t, 4, *report.Results[0].Issue.Status.ID)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+	// the template is not modified
+	assert.Equal(t, "template", *preview.Update.Comment)
+}
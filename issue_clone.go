@@ -0,0 +1,383 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CloneIssueMapping maps the names of attributes in the source project to the
+// names in the target project. Attributes not in the mapping keep their names,
+// and an attribute mapped to an empty string is dropped.
+type CloneIssueMapping struct {
+	IssueTypes   map[string]string
+	Categories   map[string]string
+	Versions     map[string]string // applied to both versions and milestones
+	Statuses     map[string]string
+	CustomFields map[string]string
+	Users        map[string]string // keyed by userId
+}
+
+// CloneIssueOptions specifies parameters to the CloneIssue method.
+type CloneIssueOptions struct {
+	TargetProjectIDOrKey interface{}
+	Mapping              *CloneIssueMapping
+	// WithChildren clones the child issues as well
+	WithChildren bool
+	// WithComments re-creates comments as quoted history
+	WithComments bool
+	// WithAttachments downloads attachments and uploads them again
+	WithAttachments bool
+	// CloseSource closes the source issues with a comment linking to the clones
+	CloseSource bool
+}
+
+// CloneIssuesResult : the result of cloning issues
+type CloneIssuesResult struct {
+	// KeyMap maps the keys of the source issues to the keys of the clones
+	KeyMap map[string]string
+	Issues []*Issue
+}
+
+// CloneIssue re-creates an issue in another project
+func (c *Client) CloneIssue(issueIDOrKey string, opts *CloneIssueOptions) (*CloneIssuesResult, error) {
+	return c.CloneIssueContext(context.Background(), issueIDOrKey, opts)
+}
+
+// CloneIssueContext re-creates an issue in another project with context
+func (c *Client) CloneIssueContext(ctx context.Context, issueIDOrKey string, opts *CloneIssueOptions, callOpts ...CallOption) (*CloneIssuesResult, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	return c.CloneIssuesContext(ctx, []string{issueIDOrKey}, opts)
+}
+
+// CloneIssues re-creates issues in another project
+func (c *Client) CloneIssues(issueIDOrKeys []string, opts *CloneIssueOptions) (*CloneIssuesResult, error) {
+	return c.CloneIssuesContext(context.Background(), issueIDOrKeys, opts)
+}
+
+// CloneIssuesContext re-creates issues in another project with context.
+//
+// Parent/child relations among the cloned issues are kept. If an error occurs
+// on the way, the issues cloned so far are returned with the error, including
+// the issue being cloned if it has been created.
+func (c *Client) CloneIssuesContext(ctx context.Context, issueIDOrKeys []string, opts *CloneIssueOptions, callOpts ...CallOption) (*CloneIssuesResult, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	if opts == nil || opts.TargetProjectIDOrKey == nil {
+		return nil, errors.New("target project must be specified")
+	}
+	mapping := opts.Mapping
+	if mapping == nil {
+		mapping = &CloneIssueMapping{}
+	}
+
+	sources, err := c.cloneSourcesContext(ctx, issueIDOrKeys, opts.WithChildren)
+	if err != nil {
+		return nil, err
+	}
+
+	r := c.NewResolver(opts.TargetProjectIDOrKey)
+	result := &CloneIssuesResult{KeyMap: map[string]string{}}
+	newIDs := map[int]int{}
+	for _, src := range sources {
+		issue, err := c.cloneIssueContext(ctx, r, src, mapping, newIDs, opts)
+		if issue != nil {
+			newIDs[*src.ID] = *issue.ID
+			result.KeyMap[*src.IssueKey] = *issue.IssueKey
+			result.Issues = append(result.Issues, issue)
+		}
+		if err != nil {
+			return result, errors.Wrapf(err, "failed to clone issue %s", derefString(src.IssueKey))
+		}
+	}
+
+	if opts.CloseSource {
+		for _, src := range sources {
+			if _, err := c.UpdateIssueContext(ctx, *src.IssueKey, &UpdateIssueInput{
+				StatusID: StatusClosed.Ptr(),
+				Comment:  String(fmt.Sprintf("Moved to %s", result.KeyMap[*src.IssueKey])),
+			}); err != nil {
+				return result, errors.Wrapf(err, "failed to close issue %s", *src.IssueKey)
+			}
+		}
+	}
+	return result, nil
+}
+
+// cloneSourcesContext returns the source issues with parents placed before their children
+func (c *Client) cloneSourcesContext(ctx context.Context, issueIDOrKeys []string, withChildren bool) ([]*Issue, error) {
+	var sources []*Issue
+	seen := map[int]bool{}
+	for _, key := range issueIDOrKeys {
+		issue, err := c.GetIssueContext(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if seen[*issue.ID] {
+			continue
+		}
+		seen[*issue.ID] = true
+		sources = append(sources, issue)
+
+		if !withChildren {
+			continue
+		}
+		children, err := c.getAllIssuesContext(ctx, &GetIssuesOptions{ParentIssueIDs: []int{*issue.ID}})
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			if !seen[*child.ID] {
+				seen[*child.ID] = true
+				sources = append(sources, child)
+			}
+		}
+	}
+
+	var ordered []*Issue
+	placed := map[int]bool{}
+	var place func(issue *Issue)
+	place = func(issue *Issue) {
+		if placed[*issue.ID] {
+			return
+		}
+		placed[*issue.ID] = true
+		if issue.ParentIssueID != nil {
+			for _, parent := range sources {
+				if *parent.ID == *issue.ParentIssueID {
+					place(parent)
+				}
+			}
+		}
+		ordered = append(ordered, issue)
+	}
+	for _, issue := range sources {
+		place(issue)
+	}
+	return ordered, nil
+}
+
+// mapName returns the mapped name and false if the name is mapped to be dropped
+func mapName(m map[string]string, name string) (string, bool) {
+	if v, ok := m[name]; ok {
+		return v, v != ""
+	}
+	return name, true
+}
+
+// cloneIssueContext creates a clone of src. Once the clone is created, it is
+// returned even with an error of the later steps.
+func (c *Client) cloneIssueContext(ctx context.Context, r *Resolver, src *Issue, mapping *CloneIssueMapping, newIDs map[int]int, opts *CloneIssueOptions) (*Issue, error) {
+	spec := &IssueSpec{
+		Summary:        src.Summary,
+		Description:    src.Description,
+		EstimatedHours: src.EstimatedHours,
+		ActualHours:    src.ActualHours,
+	}
+	if src.StartDate != nil {
+		spec.StartDate = String(issueDate(src.StartDate))
+	}
+	if src.DueDate != nil {
+		spec.DueDate = String(issueDate(src.DueDate))
+	}
+	if src.IssueType != nil {
+		if name, ok := mapName(mapping.IssueTypes, derefString(src.IssueType.Name)); ok {
+			spec.IssueType = String(name)
+		}
+	}
+	if src.Assignee != nil {
+		if name, ok := mapName(mapping.Users, derefString(src.Assignee.UserID)); ok {
+			spec.Assignee = String(name)
+		}
+	}
+	for _, v := range src.Category {
+		if name, ok := mapName(mapping.Categories, derefString(v.Name)); ok {
+			spec.Categories = append(spec.Categories, name)
+		}
+	}
+	for _, v := range src.Versions {
+		if name, ok := mapName(mapping.Versions, derefString(v.Name)); ok {
+			spec.Versions = append(spec.Versions, name)
+		}
+	}
+	for _, v := range src.Milestone {
+		if name, ok := mapName(mapping.Versions, derefString(v.Name)); ok {
+			spec.Milestones = append(spec.Milestones, name)
+		}
+	}
+	for _, cf := range src.CustomFields {
+		name, ok := mapName(mapping.CustomFields, derefString(cf.Name))
+		if !ok || cf.Value == nil {
+			continue
+		}
+		if spec.CustomFields == nil {
+			spec.CustomFields = map[string]interface{}{}
+		}
+		if isListCustomField(cf.FieldTypeID) {
+			names := customFieldItemNames(cf.Value)
+			if len(names) == 0 {
+				continue
+			}
+			spec.CustomFields[name] = names
+		} else {
+			spec.CustomFields[name] = cf.Value
+		}
+	}
+
+	input, err := r.CreateIssueInputContext(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	// priorities are shared in a space
+	if src.Priority != nil {
+		input.PriorityID = src.Priority.ID
+	}
+	if src.ParentIssueID != nil {
+		if id, ok := newIDs[*src.ParentIssueID]; ok {
+			input.ParentIssueID = Int(id)
+		}
+	}
+	if opts.WithAttachments && len(src.Attachments) > 0 {
+		ids, err := c.copyIssueAttachmentsContext(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+		input.AttachmentIDs = ids
+	}
+
+	issue, err := c.CreateIssueContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if src.Status != nil {
+		if name, ok := mapName(mapping.Statuses, derefString(src.Status.Name)); ok {
+			status, err := r.StatusContext(ctx, name)
+			if err != nil {
+				return issue, err
+			}
+			if issue.Status == nil || *issue.Status.ID != *status.ID {
+				update := &UpdateIssueInput{StatusID: status.ID}
+				if src.Resolution != nil && src.Resolution.ID != nil {
+					update.ResolutionID = *src.Resolution.ID
+				}
+				updated, err := c.UpdateIssueContext(ctx, *issue.IssueKey, update)
+				if err != nil {
+					return issue, err
+				}
+				issue = updated
+			}
+		}
+	}
+
+	if opts.WithComments {
+		comments, err := c.getAllIssueCommentsContext(ctx, *src.IssueKey)
+		if err != nil {
+			return issue, err
+		}
+		for _, comment := range comments {
+			if derefString(comment.Content) == "" {
+				continue
+			}
+			if _, err := c.CreateIssueCommentContext(ctx, *issue.IssueKey, &CreateIssueCommentInput{
+				Content: String(quoteIssueComment(comment)),
+			}); err != nil {
+				return issue, err
+			}
+		}
+	}
+	return issue, nil
+}
+
+// copyIssueAttachmentsContext downloads the attachments of an issue and uploads them again
+func (c *Client) copyIssueAttachmentsContext(ctx context.Context, src *Issue) (ids []int, err error) {
+	dir, err := os.MkdirTemp("", "backlog-clone-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if er := os.RemoveAll(dir); er != nil && err == nil {
+			err = er
+		}
+	}()
+
+	for i, attachment := range src.Attachments {
+		// each file has its own directory to keep the original name
+		fdir := filepath.Join(dir, fmt.Sprint(i))
+		if err := os.Mkdir(fdir, 0o700); err != nil {
+			return nil, err
+		}
+		fpath := filepath.Join(fdir, filepath.Base(derefString(attachment.Name)))
+		if err := c.downloadIssueAttachmentContext(ctx, *src.IssueKey, *attachment.ID, fpath); err != nil {
+			return nil, err
+		}
+		uploaded, err := c.UploadFileContext(ctx, fpath)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, *uploaded.ID)
+	}
+	return ids, nil
+}
+
+func (c *Client) downloadIssueAttachmentContext(ctx context.Context, issueKey string, attachmentID int, fpath string) (err error) {
+	file, err := os.Create(filepath.Clean(fpath))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if er := file.Close(); er != nil && err == nil {
+			err = er
+		}
+	}()
+	return c.GetIssueAttachmentContext(ctx, issueKey, attachmentID, file)
+}
+
+// customFieldItemNames returns the names of list items decoded from a response
+func customFieldItemNames(v interface{}) []string {
+	var names []string
+	add := func(item interface{}) {
+		switch i := item.(type) {
+		case map[string]interface{}:
+			if name, ok := i["name"].(string); ok {
+				names = append(names, name)
+			}
+		case *Item:
+			names = append(names, derefString(i.Name))
+		}
+	}
+	switch value := v.(type) {
+	case []interface{}:
+		for _, item := range value {
+			add(item)
+		}
+	case []*Item:
+		for _, item := range value {
+			add(item)
+		}
+	default:
+		add(value)
+	}
+	return names
+}
+
+// quoteIssueComment formats a comment as quoted history
+func quoteIssueComment(comment *IssueComment) string {
+	author := ""
+	if comment.CreatedUser != nil {
+		author = derefString(comment.CreatedUser.Name)
+	}
+	created := ""
+	if comment.Created != nil {
+		created = comment.Created.Format("2006-01-02 15:04")
+	}
+
+	lines := strings.Split(derefString(comment.Content), "\n")
+	for i, line := range lines {
+		lines[i] = "> " + line
+	}
+	return fmt.Sprintf("%s (%s):\n%s", author, created, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,231 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handle := func(path, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			if _, err := fmt.Fprint(w, body); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	// target project
+	handle("/projects/NEW", `{"id": 2, "projectKey": "NEW"}`)
+	handle("/projects/NEW/statuses", `[{"id": 1, "name": "未対応"}, {"id": 2, "name": "処理中"}]`)
+	handle("/projects/NEW/issueTypes", `[{"id": 20, "name": "Task"}]`)
+	handle("/projects/NEW/categories", `[{"id": 21, "name": "Backend"}]`)
+	handle("/projects/NEW/versions", `[{"id": 22, "name": "v1"}]`)
+	handle("/projects/NEW/customFields", `[{"id": 23, "typeId": 6, "name": "OS", "items": [{"id": 24, "name": "Linux"}]}]`)
+	handle("/projects/NEW/users", `[{"id": 1, "userId": "admin", "name": "admin"}]`)
+
+	// source issues
+	sources := map[string]string{"BLG-1": `{
+		"id": 1, "projectId": 1, "issueKey": "BLG-1", "summary": "parent",
+		"issueType": {"id": 10, "name": "タスク"},
+		"priority": {"id": 2, "name": "高"},
+		"status": {"id": 2, "name": "処理中"},
+		"resolution": {"id": 0, "name": "対応済み"},
+		"assignee": {"id": 1, "userId": "admin", "name": "admin"},
+		"category": [{"id": 11, "name": "Backend"}, {"id": 12, "name": "Obsolete"}],
+		"milestone": [{"id": 13, "name": "v1"}],
+		"startDate": "2019-01-07T00:00:00Z",
+		"customFields": [{"id": 14, "fieldTypeId": 6, "name": "OS", "value": [{"id": 15, "name": "Linux"}]}],
+		"attachments": [{"id": 16, "name": "log.txt", "size": 5}]
+	}`}
+	handle("/issues/BLG-1/attachments/16", "hello")
+	mux.HandleFunc("/issues/BLG-1/comments", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "asc", r.URL.Query().Get("order"))
+		assert.Equal(t, "100", r.URL.Query().Get("count"))
+		if _, err := fmt.Fprint(w, `[
+			{"id": 100, "content": "first\nsecond", "createdUser": {"id": 1, "name": "admin"}, "created": "2019-01-07T10:00:00Z"},
+			{"id": 101, "content": "", "changeLog": [{"field": "status"}]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	handle("/issues/BLG-2/comments", `[]`)
+
+	mux.HandleFunc("/space/attachment", func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(file)
+		assert.Equal(t, "log.txt", header.Filename)
+		assert.Equal(t, "hello", string(b))
+		if _, err := fmt.Fprint(w, `{"id": 30, "name": "log.txt", "size": 5}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var mu sync.Mutex
+	var created []map[string]interface{}
+	var comments []string
+	var updates []string
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			assert.Equal(t, "1", r.URL.Query().Get("parentIssueId[]"))
+			if _, err := fmt.Fprint(w, `[{
+				"id": 2, "projectId": 1, "issueKey": "BLG-2", "summary": "child", "parentIssueId": 1,
+				"issueType": {"id": 10, "name": "タスク"},
+				"priority": {"id": 3, "name": "中"},
+				"status": {"id": 1, "name": "未対応"}
+			}]`); err != nil {
+				t.Fatal(err)
+			}
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		var input map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			t.Fatal(err)
+		}
+		input["customField_23"] = r.URL.Query().Get("customField_23")
+		created = append(created, input)
+		id := 200 + len(created)
+		if _, err := fmt.Fprintf(w, `{"id": %d, "issueKey": "NEW-%d", "status": {"id": 1, "name": "未対応"}}`, id, len(created)); err != nil {
+			t.Fatal(err)
+		}
+	})
+	ids := map[string]int{"BLG-1": 1, "BLG-2": 2, "NEW-1": 201, "NEW-2": 202}
+	for key, id := range ids {
+		key, id := key, id
+		mux.HandleFunc("/issues/"+key, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "GET" {
+				if _, err := fmt.Fprint(w, sources[key]); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			testMethod(t, r, "PATCH")
+			mu.Lock()
+			defer mu.Unlock()
+			b, _ := io.ReadAll(r.Body)
+			updates = append(updates, key+" "+strings.TrimSpace(string(b)))
+			if _, err := fmt.Fprintf(w, `{"id": %d, "issueKey": "%s"}`, id, key); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	for _, key := range []string{"NEW-1", "NEW-2"} {
+		mux.HandleFunc("/issues/"+key+"/comments", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			mu.Lock()
+			defer mu.Unlock()
+			var input map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+			comments = append(comments, input["content"].(string))
+			if _, err := fmt.Fprint(w, `{"id": 1}`); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	result, err := client.CloneIssue("BLG-1", &CloneIssueOptions{
+		TargetProjectIDOrKey: "NEW",
+		Mapping: &CloneIssueMapping{
+			IssueTypes: map[string]string{"タスク": "Task"},
+			Categories: map[string]string{"Obsolete": ""},
+		},
+		WithChildren:    true,
+		WithComments:    true,
+		WithAttachments: true,
+		CloseSource:     true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Equal(t, map[string]string{"BLG-1": "NEW-1", "BLG-2": "NEW-2"}, result.KeyMap)
+	assert.Len(t, result.Issues, 2)
+
+	assert.Len(t, created, 2)
+	assert.Equal(t, float64(2), created[0]["projectId"])
+	assert.Equal(t, float64(20), created[0]["issueTypeId"])
+	assert.Equal(t, float64(2), created[0]["priorityId"])
+	assert.Equal(t, float64(1), created[0]["assigneeId"])
+	assert.Equal(t, []interface{}{float64(21)}, created[0]["categoryId"])
+	assert.Equal(t, []interface{}{float64(22)}, created[0]["milestoneId"])
+	assert.Equal(t, []interface{}{float64(30)}, created[0]["attachmentId"])
+	assert.Equal(t, "2019-01-07", created[0]["startDate"])
+	assert.Equal(t, "24", created[0]["customField_23"])
+	assert.Equal(t, float64(201), created[1]["parentIssueId"])
+
+	assert.Equal(t, []string{"admin (2019-01-07 10:00):\n> first\n> second"}, comments)
+	assert.Equal(t, []string{
+		`NEW-1 {"statusId":2,"resolutionId":0}`,
+		`BLG-1 {"statusId":4,"comment":"Moved to NEW-1"}`,
+		`BLG-2 {"statusId":4,"comment":"Moved to NEW-2"}`,
+	}, updates)
+}
+
+func TestCloneIssuesFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	_, err := client.CloneIssue("BLG-1", nil)
+	assert.Error(t, err)
+
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	_, err = client.CloneIssue("BLG-1", &CloneIssueOptions{TargetProjectIDOrKey: "NEW"})
+	assert.Error(t, err)
+}
+
+func TestCloneIssues_StatusUpdateFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handle := func(path, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			if _, err := fmt.Fprint(w, body); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	handle("/projects/NEW", `{"id": 2, "projectKey": "NEW"}`)
+	handle("/projects/NEW/statuses", `[{"id": 1, "name": "未対応"}, {"id": 2, "name": "処理中"}]`)
+	handle("/projects/NEW/issueTypes", `[{"id": 20, "name": "タスク"}]`)
+	handle("/issues/BLG-1", `{
+		"id": 1, "projectId": 1, "issueKey": "BLG-1", "summary": "parent",
+		"issueType": {"id": 10, "name": "タスク"},
+		"priority": {"id": 2, "name": "高"},
+		"status": {"id": 2, "name": "処理中"}
+	}`)
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if _, err := fmt.Fprint(w, `{"id": 201, "issueKey": "NEW-1", "status": {"id": 1, "name": "未対応"}}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/issues/NEW-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	result, err := client.CloneIssue("BLG-1", &CloneIssueOptions{TargetProjectIDOrKey: "NEW"})
+	assert.Error(t, err)
+	// the clone has been created, so it is reported
+	assert.Equal(t, map[string]string{"BLG-1": "NEW-1"}, result.KeyMap)
+	assert.Len(t, result.Issues, 1)
+}
@@ -0,0 +1,310 @@
+package backlog
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IssueDiff : the fields changed from an issue to another
+type IssueDiff []*IssueFieldDiff
+
+// IssueFieldDiff : a changed field.
+//
+// Values are formatted as in BulkUpdateChange: attributes and list items are
+// shown by their IDs, multiple IDs are sorted and joined by commas, and dates
+// are formatted as yyyy-MM-dd. A nil field and an empty field are equal.
+type IssueFieldDiff struct {
+	Field         IssueField
+	CustomFieldID int    // set if Field is IssueFieldCustomFields
+	Name          string // the custom field name if Field is IssueFieldCustomFields
+	From          string
+	To            string
+	// Added and Removed are the IDs added and removed in a multi-valued field
+	Added   []int
+	Removed []int
+}
+
+// DiffIssues returns the fields changed from a to b.
+// Categories, versions, milestones and list custom fields are compared as sets.
+// A nil issue is compared as an issue with no fields, such as before creation.
+func DiffIssues(a, b *Issue) IssueDiff {
+	if a == nil {
+		a = &Issue{}
+	}
+	if b == nil {
+		b = &Issue{}
+	}
+	var diff IssueDiff
+	add := func(field IssueField, from, to string) {
+		if from != to {
+			diff = append(diff, &IssueFieldDiff{Field: field, From: from, To: to})
+		}
+	}
+	addSet := func(d *IssueFieldDiff, from, to []int) {
+		d.From, d.To = idsString(from), idsString(to)
+		if d.From != d.To {
+			d.Added, d.Removed = diffIDs(from, to), diffIDs(to, from)
+			diff = append(diff, d)
+		}
+	}
+
+	add(IssueFieldSummary, derefString(a.Summary), derefString(b.Summary))
+	add(IssueFieldDescription, derefString(a.Description), derefString(b.Description))
+	add(IssueFieldIssueType, issueTypeIDString(a.IssueType), issueTypeIDString(b.IssueType))
+	add(IssueFieldStatus, statusIDString(a.Status), statusIDString(b.Status))
+	add(IssueFieldResolution, resolutionIDString(a.Resolution), resolutionIDString(b.Resolution))
+	add(IssueFieldPriority, priorityIDString(a.Priority), priorityIDString(b.Priority))
+	add(IssueFieldAssignee, userIDString(a.Assignee), userIDString(b.Assignee))
+
+	addSet(&IssueFieldDiff{Field: IssueFieldCategory}, categoryIDs(a.Category), categoryIDs(b.Category))
+	addSet(&IssueFieldDiff{Field: IssueFieldVersions}, versionIDs(a.Versions), versionIDs(b.Versions))
+	addSet(&IssueFieldDiff{Field: IssueFieldMilestone}, milestoneIDs(a.Milestone), milestoneIDs(b.Milestone))
+
+	add(IssueFieldStartDate, issueDate(a.StartDate), issueDate(b.StartDate))
+	add(IssueFieldDueDate, issueDate(a.DueDate), issueDate(b.DueDate))
+	add(IssueFieldEstimatedHours, floatPtrString(a.EstimatedHours), floatPtrString(b.EstimatedHours))
+	add(IssueFieldActualHours, floatPtrString(a.ActualHours), floatPtrString(b.ActualHours))
+	add(IssueFieldParentIssueID, intPtrString(a.ParentIssueID), intPtrString(b.ParentIssueID))
+
+	fields := map[int][2]*IssueCustomField{}
+	for _, cf := range a.CustomFields {
+		if cf.ID != nil {
+			fields[*cf.ID] = [2]*IssueCustomField{cf, fields[*cf.ID][1]}
+		}
+	}
+	for _, cf := range b.CustomFields {
+		if cf.ID != nil {
+			fields[*cf.ID] = [2]*IssueCustomField{fields[*cf.ID][0], cf}
+		}
+	}
+	ids := make([]int, 0, len(fields))
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		from, to := fields[id][0], fields[id][1]
+		d := &IssueFieldDiff{Field: IssueFieldCustomFields, CustomFieldID: id}
+		var fromValue, toValue interface{}
+		var typeID *int
+		for _, cf := range []*IssueCustomField{from, to} {
+			if cf == nil {
+				continue
+			}
+			d.Name = derefString(cf.Name)
+			if cf.FieldTypeID != nil {
+				typeID = cf.FieldTypeID
+			}
+		}
+		if from != nil {
+			fromValue = from.Value
+		}
+		if to != nil {
+			toValue = to.Value
+		}
+
+		if isListCustomField(typeID) {
+			addSet(d, customFieldItemIDs(fromValue), customFieldItemIDs(toValue))
+			continue
+		}
+		d.From, d.To = customFieldValueString(fromValue), customFieldValueString(toValue)
+		if d.From != d.To {
+			diff = append(diff, d)
+		}
+	}
+	return diff
+}
+
+// UpdateIssueInput returns the minimal input to update an issue from the
+// old state of the diff to the new one. UpdateIssueInput cannot clear
+// categories, versions, milestones, the issue type, the status, the priority
+// or the parent issue, so such changes are left out of input and returned
+// as unapplied.
+func (d IssueDiff) UpdateIssueInput() (input *UpdateIssueInput, unapplied IssueDiff) {
+	input = &UpdateIssueInput{}
+	for _, f := range d {
+		if f.To == "" && !isClearableIssueField(f.Field) {
+			unapplied = append(unapplied, f)
+			continue
+		}
+		switch f.Field {
+		case IssueFieldSummary:
+			input.Summary = String(f.To)
+		case IssueFieldDescription:
+			input.Description = String(f.To)
+		case IssueFieldIssueType:
+			input.IssueTypeID = atoiPtr(f.To)
+		case IssueFieldStatus:
+			input.StatusID = atoiPtr(f.To)
+		case IssueFieldResolution:
+			input.ResolutionID = clearableInt(f.To)
+		case IssueFieldPriority:
+			input.PriorityID = atoiPtr(f.To)
+		case IssueFieldAssignee:
+			input.AssigneeID = clearableInt(f.To)
+		case IssueFieldCategory:
+			input.CategoryIDs = parseIDs(f.To)
+		case IssueFieldVersions:
+			input.VersionIDs = parseIDs(f.To)
+		case IssueFieldMilestone:
+			input.MilestoneIDs = parseIDs(f.To)
+		case IssueFieldStartDate:
+			input.StartDate = String(f.To)
+		case IssueFieldDueDate:
+			input.DueDate = String(f.To)
+		case IssueFieldEstimatedHours:
+			input.EstimatedHours = clearableFloat(f.To)
+		case IssueFieldActualHours:
+			input.ActualHours = clearableFloat(f.To)
+		case IssueFieldParentIssueID:
+			input.ParentIssueID = atoiPtr(f.To)
+		case IssueFieldCustomFields:
+			cf := &IssueCustomField{ID: Int(f.CustomFieldID), Name: String(f.Name), Value: f.To}
+			if f.Added != nil || f.Removed != nil {
+				items := []*Item{}
+				for _, id := range parseIDs(f.To) {
+					items = append(items, &Item{ID: Int(id)})
+				}
+				cf.Value = items
+			}
+			input.CustomFields = append(input.CustomFields, cf)
+		}
+	}
+	return input, unapplied
+}
+
+// isClearableIssueField returns if UpdateIssueInput can clear the field
+func isClearableIssueField(f IssueField) bool {
+	switch f {
+	case IssueFieldIssueType, IssueFieldStatus, IssueFieldPriority, IssueFieldCategory,
+		IssueFieldVersions, IssueFieldMilestone, IssueFieldParentIssueID:
+		return false
+	}
+	return true
+}
+
+func issueTypeIDString(v *IssueType) string {
+	if v == nil {
+		return ""
+	}
+	return intPtrString(v.ID)
+}
+
+func statusIDString(v *Status) string {
+	if v == nil {
+		return ""
+	}
+	return intPtrString(v.ID)
+}
+
+func resolutionIDString(v *Resolution) string {
+	if v == nil {
+		return ""
+	}
+	return intPtrString(v.ID)
+}
+
+func priorityIDString(v *Priority) string {
+	if v == nil {
+		return ""
+	}
+	return intPtrString(v.ID)
+}
+
+func userIDString(v *User) string {
+	if v == nil {
+		return ""
+	}
+	return intPtrString(v.ID)
+}
+
+func categoryIDs(v []*Category) []int {
+	var ids []int
+	for _, c := range v {
+		if c.ID != nil {
+			ids = append(ids, *c.ID)
+		}
+	}
+	return ids
+}
+
+func versionIDs(v []*Version) []int {
+	var ids []int
+	for _, c := range v {
+		if c.ID != nil {
+			ids = append(ids, *c.ID)
+		}
+	}
+	return ids
+}
+
+func milestoneIDs(v []*Milestone) []int {
+	var ids []int
+	for _, c := range v {
+		if c.ID != nil {
+			ids = append(ids, *c.ID)
+		}
+	}
+	return ids
+}
+
+// customFieldItemIDs returns the IDs of list items of a custom field value
+func customFieldItemIDs(v interface{}) []int {
+	if v == nil {
+		return nil
+	}
+	return parseIDs(customFieldValueString(v))
+}
+
+// diffIDs returns the sorted IDs in b but not in a
+func diffIDs(a, b []int) []int {
+	in := map[int]bool{}
+	for _, id := range a {
+		in[id] = true
+	}
+	var ids []int
+	for _, id := range b {
+		if !in[id] {
+			in[id] = true
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// parseIDs parses IDs joined by commas
+func parseIDs(s string) []int {
+	var ids []int
+	for _, v := range strings.Split(s, ",") {
+		if id, err := strconv.Atoi(v); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func atoiPtr(s string) *int {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// clearableInt returns an int, or an empty string to clear the field
+func clearableInt(s string) interface{} {
+	if id, err := strconv.Atoi(s); err == nil {
+		return id
+	}
+	return ""
+}
+
+// clearableFloat returns a float64, or an empty string to clear the field
+func clearableFloat(s string) interface{} {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+package backlog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffIssues(t *testing.T) {
+	var a, b Issue
+	if err := json.Unmarshal([]byte(`{
+		"summary": "summary",
+		"status": {"id": 1},
+		"assignee": {"id": 5},
+		"category": [{"id": 1}, {"id": 2}],
+		"milestone": [{"id": 3}],
+		"dueDate": "2019-01-07T00:00:00Z",
+		"estimatedHours": 2,
+		"customFields": [
+			{"id": 40, "fieldTypeId": 1, "name": "Note", "value": "note"},
+			{"id": 41, "fieldTypeId": 7, "name": "OS", "value": [{"id": 1, "name": "Windows"}, {"id": 2, "name": "macOS"}]}
+		]
+	}`), &a); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`{
+		"summary": "summary",
+		"description": "",
+		"status": {"id": 2},
+		"category": [{"id": 2}, {"id": 1}],
+		"milestone": [{"id": 4}],
+		"dueDate": "2019-01-07",
+		"customFields": [
+			{"id": 40, "fieldTypeId": 1, "name": "Note", "value": "note"},
+			{"id": 41, "fieldTypeId": 7, "name": "OS", "value": [{"id": 2, "name": "macOS"}, {"id": 3, "name": "Linux"}]}
+		]
+	}`), &b); err != nil {
+		t.Fatal(err)
+	}
+
+	diff := DiffIssues(&a, &b)
+	assert.Equal(t, IssueDiff{
+		{Field: IssueFieldStatus, From: "1", To: "2"},
+		{Field: IssueFieldAssignee, From: "5", To: ""},
+		{Field: IssueFieldMilestone, From: "3", To: "4", Added: []int{4}, Removed: []int{3}},
+		{Field: IssueFieldEstimatedHours, From: "2", To: ""},
+		{Field: IssueFieldCustomFields, CustomFieldID: 41, Name: "OS", From: "1,2", To: "2,3", Added: []int{3}, Removed: []int{1}},
+	}, diff)
+
+	update, unapplied := diff.UpdateIssueInput()
+	assert.Empty(t, unapplied)
+	input, err := json.Marshal(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{
+		"statusId": 2,
+		"assigneeId": "",
+		"milestoneId": [4],
+		"estimatedHours": ""
+	}`, string(input))
+	assert.Equal(t, "customField_41=2&customField_41=3",
+		createQueryStringsFromIssueCustomFields(update.CustomFields))
+
+	assert.Empty(t, DiffIssues(&a, &a))
+}
+
+func TestIssueDiff_UpdateIssueInputUnapplied(t *testing.T) {
+	a := &Issue{
+		Summary:   String("summary"),
+		Category:  []*Category{{ID: Int(1)}},
+		Milestone: []*Milestone{{ID: Int(3)}},
+	}
+	b := &Issue{Summary: String("new summary")}
+
+	update, unapplied := DiffIssues(a, b).UpdateIssueInput()
+	assert.Equal(t, "new summary", *update.Summary)
+	assert.Nil(t, update.CategoryIDs)
+	assert.Nil(t, update.MilestoneIDs)
+	assert.Equal(t, IssueDiff{
+		{Field: IssueFieldCategory, From: "1", To: "", Removed: []int{1}},
+		{Field: IssueFieldMilestone, From: "3", To: "", Removed: []int{3}},
+	}, unapplied)
+}
+
+func TestDiffIssues_Nil(t *testing.T) {
+	issue := &Issue{Summary: String("summary"), Status: &Status{ID: Int(1)}}
+
+	diff := DiffIssues(nil, issue)
+	assert.Len(t, diff, 2)
+	assert.Equal(t, IssueFieldSummary, diff[0].Field)
+	assert.Equal(t, "", diff[0].From)
+	assert.Equal(t, "summary", diff[0].To)
+
+	diff = DiffIssues(issue, nil)
+	assert.Len(t, diff, 2)
+	assert.Equal(t, "1", diff[1].From)
+	assert.Equal(t, "", diff[1].To)
+
+	assert.Empty(t, DiffIssues(nil, nil))
+}
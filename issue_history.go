@@ -0,0 +1,260 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// changeLogFields maps the field names of change logs to the fields of an issue
+var changeLogFields = map[string]IssueField{
+	"summary":        IssueFieldSummary,
+	"description":    IssueFieldDescription,
+	"issueType":      IssueFieldIssueType,
+	"status":         IssueFieldStatus,
+	"resolution":     IssueFieldResolution,
+	"priority":       IssueFieldPriority,
+	"assigner":       IssueFieldAssignee,
+	"component":      IssueFieldCategory,
+	"version":        IssueFieldVersions,
+	"milestone":      IssueFieldMilestone,
+	"startDate":      IssueFieldStartDate,
+	"limitDate":      IssueFieldDueDate,
+	"estimatedHours": IssueFieldEstimatedHours,
+	"actualHours":    IssueFieldActualHours,
+	"parentIssue":    IssueFieldParentIssueID,
+	"attachment":     IssueFieldAttachments,
+}
+
+// IssueTransition : a change of a field of an issue.
+//
+// Values are shown as in change logs: attributes by their names, multiple
+// names joined by ", ", dates as yyyy-MM-dd and the parent issue by its key.
+// Change logs have no IDs of attributes, so From and To of a status, for
+// example, are names such as "処理中", not StatusIDs. Look the names up in
+// GetStatuses, GetPriorities, GetResolutions or GetIssueTypes for the IDs.
+// Fields unknown to this package keep the field names of the change logs.
+type IssueTransition struct {
+	Field     IssueField
+	Name      string // the custom field name if Field is IssueFieldCustomFields
+	From      string // the value before the change, "" if it was not set
+	To        string // the value after the change, "" if it is cleared
+	Changed   time.Time
+	ChangedBy *User
+	CommentID int
+}
+
+// IssueState : the values of the fields of an issue at a point in time,
+// formatted in the same way as IssueTransition
+type IssueState struct {
+	At           time.Time
+	Fields       map[IssueField]string
+	CustomFields map[string]string // keyed by custom field name
+	Attachments  []string          // attachment names
+}
+
+// IssueHistory : the current state of an issue and the transitions in chronological order
+type IssueHistory struct {
+	Issue       *Issue
+	Current     *IssueState
+	Transitions []*IssueTransition
+}
+
+// GetIssueHistory returns the history of an issue built from the change logs of its comments
+func (c *Client) GetIssueHistory(issueIDOrKey string) (*IssueHistory, error) {
+	return c.GetIssueHistoryContext(context.Background(), issueIDOrKey)
+}
+
+// GetIssueHistoryContext returns the history of an issue built from the change logs of its comments with context
+func (c *Client) GetIssueHistoryContext(ctx context.Context, issueIDOrKey string, callOpts ...CallOption) (*IssueHistory, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	issue, err := c.GetIssueContext(ctx, issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := c.getAllIssueCommentsContext(ctx, issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+
+	parentKey := ""
+	if issue.ParentIssueID != nil {
+		parent, err := c.GetIssueContext(ctx, fmt.Sprint(*issue.ParentIssueID))
+		if err != nil {
+			return nil, err
+		}
+		parentKey = derefString(parent.IssueKey)
+	}
+
+	return &IssueHistory{
+		Issue:       issue,
+		Current:     currentIssueState(issue, parentKey),
+		Transitions: issueTransitions(comments),
+	}, nil
+}
+
+// StateAt returns the state of the issue at t by reverting the transitions after t.
+// It returns nil if the issue was not created yet at t.
+func (h *IssueHistory) StateAt(t time.Time) *IssueState {
+	if h.Issue.Created != nil && t.Before(h.Issue.Created.Time) {
+		return nil
+	}
+
+	state := &IssueState{
+		At:           t,
+		Fields:       map[IssueField]string{},
+		CustomFields: map[string]string{},
+		Attachments:  append([]string{}, h.Current.Attachments...),
+	}
+	for k, v := range h.Current.Fields {
+		state.Fields[k] = v
+	}
+	for k, v := range h.Current.CustomFields {
+		state.CustomFields[k] = v
+	}
+
+	for i := len(h.Transitions) - 1; i >= 0; i-- {
+		tr := h.Transitions[i]
+		if !tr.Changed.After(t) {
+			break
+		}
+		switch tr.Field {
+		case IssueFieldCustomFields:
+			state.CustomFields[tr.Name] = tr.From
+		case IssueFieldAttachments:
+			if tr.To != "" {
+				state.Attachments = removeString(state.Attachments, tr.To)
+			}
+			if tr.From != "" {
+				state.Attachments = append(state.Attachments, tr.From)
+			}
+		default:
+			// change logs of other things than issue fields, such as notifications
+			if isIssueField(tr.Field) {
+				state.Fields[tr.Field] = tr.From
+			}
+		}
+	}
+	return state
+}
+
+// isIssueField returns if f is a field of an issue which change logs record
+func isIssueField(f IssueField) bool {
+	for _, v := range changeLogFields {
+		if v == f {
+			return true
+		}
+	}
+	return false
+}
+
+// issueTransitions returns the transitions recorded in comments in ascending order
+func issueTransitions(comments []*IssueComment) []*IssueTransition {
+	var transitions []*IssueTransition
+	for _, comment := range comments {
+		var changed time.Time
+		if comment.Created != nil {
+			changed = comment.Created.Time
+		}
+		commentID := 0
+		if comment.ID != nil {
+			commentID = *comment.ID
+		}
+		for _, log := range comment.ChangeLog {
+			field := derefString(log.Field)
+			tr := &IssueTransition{
+				From:      derefString(log.OriginalValue),
+				To:        derefString(log.NewValue),
+				Changed:   changed,
+				ChangedBy: comment.CreatedUser,
+				CommentID: commentID,
+			}
+			if f, ok := changeLogFields[field]; ok {
+				tr.Field = f
+			} else if log.AttributeInfo != nil {
+				tr.Field = IssueFieldCustomFields
+				tr.Name = field
+			} else {
+				tr.Field = IssueField(field)
+			}
+			transitions = append(transitions, tr)
+		}
+	}
+	return transitions
+}
+
+// currentIssueState returns the state of an issue formatted as change logs
+func currentIssueState(issue *Issue, parentKey string) *IssueState {
+	state := &IssueState{
+		Fields:       map[IssueField]string{},
+		CustomFields: map[string]string{},
+	}
+	if issue.Updated != nil {
+		state.At = issue.Updated.Time
+	}
+
+	state.Fields[IssueFieldSummary] = derefString(issue.Summary)
+	state.Fields[IssueFieldDescription] = derefString(issue.Description)
+	if issue.IssueType != nil {
+		state.Fields[IssueFieldIssueType] = derefString(issue.IssueType.Name)
+	}
+	if issue.Status != nil {
+		state.Fields[IssueFieldStatus] = derefString(issue.Status.Name)
+	}
+	if issue.Resolution != nil {
+		state.Fields[IssueFieldResolution] = derefString(issue.Resolution.Name)
+	}
+	if issue.Priority != nil {
+		state.Fields[IssueFieldPriority] = derefString(issue.Priority.Name)
+	}
+	if issue.Assignee != nil {
+		state.Fields[IssueFieldAssignee] = derefString(issue.Assignee.Name)
+	}
+
+	var names []string
+	for _, v := range issue.Category {
+		names = append(names, derefString(v.Name))
+	}
+	state.Fields[IssueFieldCategory] = strings.Join(names, ", ")
+	names = nil
+	for _, v := range issue.Versions {
+		names = append(names, derefString(v.Name))
+	}
+	state.Fields[IssueFieldVersions] = strings.Join(names, ", ")
+	names = nil
+	for _, v := range issue.Milestone {
+		names = append(names, derefString(v.Name))
+	}
+	state.Fields[IssueFieldMilestone] = strings.Join(names, ", ")
+
+	state.Fields[IssueFieldStartDate] = issueDate(issue.StartDate)
+	state.Fields[IssueFieldDueDate] = issueDate(issue.DueDate)
+	state.Fields[IssueFieldEstimatedHours] = floatPtrString(issue.EstimatedHours)
+	state.Fields[IssueFieldActualHours] = floatPtrString(issue.ActualHours)
+	state.Fields[IssueFieldParentIssueID] = parentKey
+
+	for _, cf := range issue.CustomFields {
+		value := ""
+		if isListCustomField(cf.FieldTypeID) {
+			value = strings.Join(customFieldItemNames(cf.Value), ", ")
+		} else if cf.Value != nil {
+			value = valueString(cf.Value)
+		}
+		state.CustomFields[derefString(cf.Name)] = value
+	}
+	for _, attachment := range issue.Attachments {
+		state.Attachments = append(state.Attachments, derefString(attachment.Name))
+	}
+	return state
+}
+
+// removeString removes the first s from a
+func removeString(a []string, s string) []string {
+	for i, v := range a {
+		if v == s {
+			return append(a[:i:i], a[i+1:]...)
+		}
+	}
+	return a
+}
@@ -0,0 +1,87 @@
+package backlog
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueHistory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues/BLG-2", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `{
+			"id": 2, "issueKey": "BLG-2", "summary": "summary", "parentIssueId": 1,
+			"status": {"id": 4, "name": "完了"},
+			"assignee": {"id": 1, "name": "taro"},
+			"dueDate": "2019-03-10T00:00:00Z",
+			"created": "2019-01-01T00:00:00Z",
+			"updated": "2019-03-05T00:00:00Z",
+			"customFields": [{"id": 41, "fieldTypeId": 5, "name": "OS", "value": {"id": 2, "name": "macOS"}}],
+			"attachments": [{"id": 1, "name": "a.txt"}, {"id": 2, "name": "b.txt"}]
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/issues/1", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `{"id": 1, "issueKey": "BLG-1"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/issues/BLG-2/comments", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `[
+			{"id": 10, "created": "2019-02-01T00:00:00Z", "createdUser": {"id": 1, "name": "taro"}, "changeLog": [
+				{"field": "status", "originalValue": "未対応", "newValue": "処理中"},
+				{"field": "assigner", "originalValue": null, "newValue": "taro"}
+			]},
+			{"id": 11, "created": "2019-03-05T00:00:00Z", "createdUser": {"id": 1, "name": "taro"}, "changeLog": [
+				{"field": "status", "originalValue": "処理中", "newValue": "完了"},
+				{"field": "limitDate", "originalValue": "2019-03-01", "newValue": "2019-03-10"},
+				{"field": "OS", "originalValue": "Windows", "newValue": "macOS", "attributeInfo": {"id": 41, "typeId": 5}},
+				{"field": "attachment", "originalValue": null, "newValue": "b.txt"},
+				{"field": "notification", "originalValue": null, "newValue": "hanako"}
+			]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	history, err := client.GetIssueHistory("BLG-2")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Len(t, history.Transitions, 7)
+	assert.Equal(t, IssueFieldAssignee, history.Transitions[1].Field)
+	assert.Equal(t, IssueFieldDueDate, history.Transitions[3].Field)
+	assert.Equal(t, IssueFieldCustomFields, history.Transitions[4].Field)
+	assert.Equal(t, "OS", history.Transitions[4].Name)
+	assert.Equal(t, IssueField("notification"), history.Transitions[6].Field)
+	assert.Equal(t, 11, history.Transitions[6].CommentID)
+	assert.Equal(t, "BLG-1", history.Current.Fields[IssueFieldParentIssueID])
+
+	state := history.StateAt(time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "処理中", state.Fields[IssueFieldStatus])
+	assert.Equal(t, "taro", state.Fields[IssueFieldAssignee])
+	assert.Equal(t, "2019-03-01", state.Fields[IssueFieldDueDate])
+	assert.Equal(t, "Windows", state.CustomFields["OS"])
+	assert.Equal(t, []string{"a.txt"}, state.Attachments)
+	// change logs of notifications are not fields of the issue
+	assert.NotContains(t, state.Fields, IssueField("notification"))
+
+	state = history.StateAt(time.Date(2019, 1, 15, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "未対応", state.Fields[IssueFieldStatus])
+	assert.Equal(t, "", state.Fields[IssueFieldAssignee])
+
+	// the current state is kept as is
+	state = history.StateAt(time.Date(2019, 3, 5, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "完了", state.Fields[IssueFieldStatus])
+	assert.Equal(t, "macOS", state.CustomFields["OS"])
+	assert.Equal(t, []string{"a.txt", "b.txt"}, state.Attachments)
+
+	assert.Nil(t, history.StateAt(time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC)))
+}
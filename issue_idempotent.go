@@ -0,0 +1,193 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// IdempotencyStore stores the keys of the issues created by CreateIssueIdempotent.
+// Keys are the project IDs and the external IDs joined by a colon.
+type IdempotencyStore interface {
+	Get(key string) (issueKey string, ok bool, err error)
+	Put(key, issueKey string) error
+}
+
+// MemoryIdempotencyStore : an IdempotencyStore in memory
+type MemoryIdempotencyStore struct {
+	mu     sync.Mutex
+	issues map[string]string
+}
+
+// NewMemoryIdempotencyStore returns a new MemoryIdempotencyStore
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{issues: map[string]string{}}
+}
+
+// Get returns the issue key stored with key
+func (s *MemoryIdempotencyStore) Get(key string) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	issueKey, ok := s.issues[key]
+	return issueKey, ok, nil
+}
+
+// Put stores the issue key with key
+func (s *MemoryIdempotencyStore) Put(key, issueKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.issues[key] = issueKey
+	return nil
+}
+
+// externalIDMarker returns the marker of an external ID in a description.
+// It is an HTML comment, but Backlog shows it as text in both the Backlog
+// and the markdown formatting, so the marker is visible.
+func externalIDMarker(externalID string) string {
+	return fmt.Sprintf("<!-- external-id: %s -->", externalID)
+}
+
+// CreateIssueIdempotent creates an issue unless an issue with the external ID exists
+func (c *Client) CreateIssueIdempotent(externalID string, input *CreateIssueInput) (*Issue, error) {
+	return c.CreateIssueIdempotentContext(context.Background(), externalID, input)
+}
+
+// CreateIssueIdempotentContext creates an issue unless an issue with the external ID exists with context.
+//
+// The external ID is recorded in the custom field set by OptionIdempotencyCustomField,
+// or in a visible marker at the end of the description. Before creating, the issue is
+// looked up in the IdempotencyStore and then searched by keyword, and the
+// existing issue is returned if found. Calls with the same external ID are
+// serialized within the Client.
+func (c *Client) CreateIssueIdempotentContext(ctx context.Context, externalID string, input *CreateIssueInput, callOpts ...CallOption) (*Issue, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	if externalID == "" {
+		return nil, errors.New("external ID must be specified")
+	}
+	if input == nil || input.ProjectID == nil {
+		return nil, errors.New("project ID must be specified")
+	}
+
+	key := fmt.Sprintf("%d:%s", *input.ProjectID, externalID)
+	c.idempotencyLocks.Lock(key)
+	defer c.idempotencyLocks.Unlock(key)
+
+	issueKey, ok, err := c.idempotencyStore.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		// if the stored issue has been deleted, fall back to searching.
+		// Other errors are returned, since searching may miss an issue the
+		// search index does not include yet and create a duplicate.
+		issue, err := c.GetIssueContext(ctx, issueKey)
+		if err == nil {
+			return issue, nil
+		}
+		if !isNotFound(err) {
+			return nil, err
+		}
+	}
+
+	issue, err := c.findIssueByExternalIDContext(ctx, *input.ProjectID, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		in := *input
+		if c.idempotencyFieldID != 0 {
+			in.CustomFields = append(append([]*IssueCustomField{}, input.CustomFields...), &IssueCustomField{
+				ID:    Int(c.idempotencyFieldID),
+				Value: externalID,
+			})
+		} else {
+			description := externalIDMarker(externalID)
+			if d := derefString(input.Description); d != "" {
+				description = d + "\n\n" + description
+			}
+			in.Description = String(description)
+		}
+		if issue, err = c.CreateIssueContext(ctx, &in); err != nil {
+			return nil, err
+		}
+	}
+
+	// issues of dry-run mode have negative placeholder IDs and are not stored
+	if issue.ID != nil && *issue.ID < 0 {
+		return issue, nil
+	}
+	if err := c.idempotencyStore.Put(key, derefString(issue.IssueKey)); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// findIssueByExternalIDContext returns the oldest issue with the external ID, or nil if not found
+func (c *Client) findIssueByExternalIDContext(ctx context.Context, projectID int, externalID string) (*Issue, error) {
+	issues, err := c.getAllIssuesContext(ctx, &GetIssuesOptions{
+		ProjectIDs: []int{projectID},
+		Keyword:    String(externalID),
+		Sort:       SortCreated,
+		Order:      OrderAsc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	marker := externalIDMarker(externalID)
+	for _, issue := range issues {
+		if c.idempotencyFieldID == 0 {
+			if strings.Contains(derefString(issue.Description), marker) {
+				return issue, nil
+			}
+			continue
+		}
+		for _, cf := range issue.CustomFields {
+			if cf.ID != nil && *cf.ID == c.idempotencyFieldID && customFieldValueString(cf.Value) == externalID {
+				return issue, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// keyedMutex : mutexes per key, released when unused
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedMutexEntry
+}
+
+type keyedMutexEntry struct {
+	mu   sync.Mutex
+	refs int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: map[string]*keyedMutexEntry{}}
+}
+
+func (m *keyedMutex) Lock(key string) {
+	m.mu.Lock()
+	e, ok := m.locks[key]
+	if !ok {
+		e = &keyedMutexEntry{}
+		m.locks[key] = e
+	}
+	e.refs++
+	m.mu.Unlock()
+	e.mu.Lock()
+}
+
+func (m *keyedMutex) Unlock(key string) {
+	m.mu.Lock()
+	e := m.locks[key]
+	e.refs--
+	if e.refs == 0 {
+		delete(m.locks, key)
+	}
+	m.mu.Unlock()
+	e.mu.Unlock()
+}
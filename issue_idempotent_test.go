@@ -0,0 +1,147 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateIssueIdempotent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var created []string
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == "GET" {
+			assert.Equal(t, "alert-1", r.URL.Query().Get("keyword"))
+			if _, err := fmt.Fprintf(w, "[%s]", strings.Join(created, ",")); err != nil {
+				t.Fatal(err)
+			}
+			return
+		}
+
+		var input map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "disk full\n\n<!-- external-id: alert-1 -->", input["description"])
+		b, err := json.Marshal(map[string]interface{}{
+			"id":          len(created) + 1,
+			"issueKey":    fmt.Sprintf("BLG-%d", len(created)+1),
+			"description": input["description"],
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, string(b))
+		if _, err := w.Write(b); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `{"id": 1, "issueKey": "BLG-1"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	input := &CreateIssueInput{ProjectID: Int(1), Summary: String("alert"), Description: String("disk full")}
+	var wg sync.WaitGroup
+	keys := make([]string, 5)
+	for i := range keys {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			issue, err := client.CreateIssueIdempotent("alert-1", input)
+			if assert.NoError(t, err) {
+				keys[i] = *issue.IssueKey
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Len(t, created, 1)
+	assert.Equal(t, []string{"BLG-1", "BLG-1", "BLG-1", "BLG-1", "BLG-1"}, keys)
+	// the input is not modified
+	assert.Equal(t, "disk full", *input.Description)
+
+	_, err := client.CreateIssueIdempotent("", input)
+	assert.Error(t, err)
+}
+
+func TestCreateIssueIdempotentWithCustomField(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.idempotencyFieldID = 10
+
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if _, err := fmt.Fprint(w, `[
+			{"id": 1, "issueKey": "BLG-1", "customFields": [{"id": 10, "value": "alert-10"}]},
+			{"id": 2, "issueKey": "BLG-2", "customFields": [{"id": 10, "value": "alert-1"}]}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	issue, err := client.CreateIssueIdempotent("alert-1", &CreateIssueInput{ProjectID: Int(1)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, "BLG-2", *issue.IssueKey)
+
+	key, ok, err := client.idempotencyStore.Get("1:alert-1")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "BLG-2", key)
+}
+
+func TestCreateIssueIdempotentStoredIssue(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		status   int
+		searched bool
+	}{
+		{"deleted", http.StatusNotFound, true},
+		{"server error", http.StatusInternalServerError, false},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+			client.idempotencyFieldID = 10
+			assert.NoError(t, client.idempotencyStore.Put("1:alert-1", "BLG-1"))
+
+			mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(c.status)
+				if _, err := fmt.Fprint(w, `{"errors": [{"message": "failed", "code": 6, "moreInfo": ""}]}`); err != nil {
+					t.Fatal(err)
+				}
+			})
+			searched := false
+			mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				searched = true
+				if _, err := fmt.Fprint(w, `[{"id": 2, "issueKey": "BLG-2", "customFields": [{"id": 10, "value": "alert-1"}]}]`); err != nil {
+					t.Fatal(err)
+				}
+			})
+
+			issue, err := client.CreateIssueIdempotent("alert-1", &CreateIssueInput{ProjectID: Int(1)})
+			assert.Equal(t, c.searched, searched)
+			if !c.searched {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, "BLG-2", *issue.IssueKey)
+			}
+		})
+	}
+}
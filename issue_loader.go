@@ -0,0 +1,159 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// defaultIssueLoaderWait is the time an IssueLoader collects IDs by default
+const defaultIssueLoaderWait = 10 * time.Millisecond
+
+// IssueNotFoundError is returned by IssueLoader when an issue is not found
+type IssueNotFoundError struct {
+	ID int
+}
+
+func (e *IssueNotFoundError) Error() string {
+	return fmt.Sprintf("issue %d is not found", e.ID)
+}
+
+// IssueLoader collects the issue IDs requested within a short time and gets
+// them in one GetIssues request of up to 100 IDs.
+// Callers of the same ID get the same *Issue, so it must not be modified
+// by a caller; copy it before modifying.
+type IssueLoader struct {
+	client *Client
+	wait   time.Duration
+
+	mu    sync.Mutex
+	batch *issueBatch
+}
+
+// issueBatch : the IDs collected to be fetched at once
+type issueBatch struct {
+	ids     map[int]bool
+	waiters int
+	done    chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	// deadline is the latest deadline of the callers.
+	// hasDeadline is false if any caller has no deadline.
+	deadline    time.Time
+	hasDeadline bool
+	timer       *time.Timer
+	issues      map[int]*Issue
+	err         error
+}
+
+// NewIssueLoader returns an IssueLoader which collects IDs for wait.
+// The default wait is 10ms.
+func (c *Client) NewIssueLoader(wait time.Duration) *IssueLoader {
+	if wait <= 0 {
+		wait = defaultIssueLoaderWait
+	}
+	return &IssueLoader{client: c, wait: wait}
+}
+
+// Load returns an issue
+func (l *IssueLoader) Load(issueID int) (*Issue, error) {
+	return l.LoadContext(context.Background(), issueID)
+}
+
+// LoadContext returns an issue with context.
+// The request is canceled when all the callers waiting for it are canceled,
+// and its deadline is the latest deadline of the callers.
+func (l *IssueLoader) LoadContext(ctx context.Context, issueID int) (*Issue, error) {
+	l.mu.Lock()
+	b := l.batch
+	if b == nil {
+		bctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		b = &issueBatch{ids: map[int]bool{}, done: make(chan struct{}), ctx: bctx, cancel: cancel, hasDeadline: true}
+		b.timer = time.AfterFunc(l.wait, func() { l.flush(b) })
+		l.batch = b
+	}
+	if deadline, ok := ctx.Deadline(); !ok {
+		b.hasDeadline = false
+	} else if deadline.After(b.deadline) {
+		b.deadline = deadline
+	}
+	b.ids[issueID] = true
+	b.waiters++
+	if len(b.ids) >= maxIssueQueryCount {
+		l.batch = nil
+		if b.timer.Stop() {
+			go l.fetch(b)
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		l.mu.Lock()
+		b.waiters--
+		if b.waiters == 0 {
+			// later callers start a new batch
+			if l.batch == b {
+				l.batch = nil
+			}
+			b.timer.Stop()
+			b.cancel()
+		}
+		l.mu.Unlock()
+		return nil, ctx.Err()
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+	issue, ok := b.issues[issueID]
+	if !ok {
+		return nil, &IssueNotFoundError{ID: issueID}
+	}
+	return issue, nil
+}
+
+// flush fetches a batch when the wait has passed
+func (l *IssueLoader) flush(b *issueBatch) {
+	l.mu.Lock()
+	if l.batch == b {
+		l.batch = nil
+	}
+	l.mu.Unlock()
+	l.fetch(b)
+}
+
+func (l *IssueLoader) fetch(b *issueBatch) {
+	defer close(b.done)
+	defer b.cancel()
+
+	ids := make([]int, 0, len(b.ids))
+	for id := range b.ids {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	ctx := b.ctx
+	if b.hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, b.deadline)
+		defer cancel()
+	}
+	issues, err := l.client.GetIssuesContext(ctx, &GetIssuesOptions{
+		IDs:   ids,
+		Count: Int(maxIssueQueryCount),
+	})
+	if err != nil {
+		b.err = err
+		return
+	}
+	b.issues = map[int]*Issue{}
+	for _, issue := range issues {
+		if issue.ID != nil {
+			b.issues[*issue.ID] = issue
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueLoader(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var requests int32
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		assert.Equal(t, "100", r.URL.Query().Get("count"))
+		var issues []string
+		for _, id := range r.URL.Query()["id[]"] {
+			// IDs ending in 1, such as 11 and 21, do not exist
+			if !strings.HasSuffix(id, "1") {
+				issues = append(issues, fmt.Sprintf(`{"id": %s, "issueKey": "BLG-%s"}`, id, id))
+			}
+		}
+		if _, err := fmt.Fprintf(w, "[%s]", strings.Join(issues, ",")); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	loader := client.NewIssueLoader(50 * time.Millisecond)
+	var wg sync.WaitGroup
+	results := make([]error, 150)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := i%120 + 10
+			issue, err := loader.Load(id)
+			if err == nil && *issue.ID != id {
+				err = errors.Errorf("got issue %d for %d", *issue.ID, id)
+			}
+			results[i] = err
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	for i, err := range results {
+		id := i%120 + 10
+		if id%10 == 1 {
+			var notFound *IssueNotFoundError
+			if assert.True(t, errors.As(err, &notFound), "id %d", id) {
+				assert.Equal(t, id, notFound.ID)
+			}
+		} else {
+			assert.NoError(t, err, "id %d", id)
+		}
+	}
+}
+
+func TestIssueLoaderCancel(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	loader := client.NewIssueLoader(50 * time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() {
+		_, err := loader.LoadContext(ctx, 1)
+		errc <- err
+	}()
+	cancel()
+	assert.ErrorIs(t, <-errc, context.Canceled)
+
+	issue, err := loader.Load(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *issue.ID)
+}
+
+func TestIssueLoaderFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := client.NewIssueLoader(0).Load(1); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+// deadlineHTTPClient records the deadlines of the requests
+type deadlineHTTPClient struct {
+	deadlines chan time.Time
+}
+
+func (c *deadlineHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	deadline, _ := req.Context().Deadline()
+	c.deadlines <- deadline
+	return http.DefaultClient.Do(req)
+}
+
+func TestIssueLoaderDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	httpClient := &deadlineHTTPClient{deadlines: make(chan time.Time, 1)}
+	client.httpclient = httpClient
+
+	now := time.Now()
+	for _, c := range []struct {
+		name      string
+		deadlines []time.Time
+		expected  time.Time
+	}{
+		{"latest", []time.Time{now.Add(time.Hour), now.Add(2 * time.Hour)}, now.Add(2 * time.Hour)},
+		{"no deadline", []time.Time{now.Add(time.Hour), {}}, time.Time{}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			loader := client.NewIssueLoader(50 * time.Millisecond)
+			var wg sync.WaitGroup
+			for i, deadline := range c.deadlines {
+				ctx := context.Background()
+				if !deadline.IsZero() {
+					var cancel context.CancelFunc
+					ctx, cancel = context.WithDeadline(ctx, deadline)
+					defer cancel()
+				}
+				wg.Add(1)
+				go func(id int) {
+					defer wg.Done()
+					_, err := loader.LoadContext(ctx, id)
+					assert.NoError(t, err)
+				}(i + 1)
+			}
+			wg.Wait()
+			assert.True(t, c.expected.Equal(<-httpClient.deadlines))
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package backlog
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// issueQueryDateLayout is the date format accepted by the issue search API.
+const issueQueryDateLayout = "2006-01-02"
+
+// maxIssueQueryCount is the largest page size accepted by the issue search API.
+const maxIssueQueryCount = 100
+
+// IssueQueryError is returned by IssueQueryBuilder when the query is invalid.
+type IssueQueryError struct {
+	Problems []string
+}
+
+func (e *IssueQueryError) Error() string {
+	return fmt.Sprintf("invalid issue query: %s", strings.Join(e.Problems, ", "))
+}
+
+// IssueQueryBuilder builds GetIssuesOptions and GetIssuesCountOptions from a
+// single set of conditions, validating them before any request is sent.
+//
+// ex.
+//
+//	opts, err := backlog.IssueQuery().
+//		Project(1).
+//		Status(1, 2).
+//		DueBefore(time.Now()).
+//		SortBy(backlog.SortDueDate, backlog.OrderAsc).
+//		Build()
+type IssueQueryBuilder struct {
+	opts     GetIssuesOptions
+	problems []string
+}
+
+// IssueQuery returns a new IssueQueryBuilder
+func IssueQuery() *IssueQueryBuilder {
+	return &IssueQueryBuilder{}
+}
+
+func (b *IssueQueryBuilder) invalid(format string, v ...interface{}) {
+	b.problems = append(b.problems, fmt.Sprintf(format, v...))
+}
+
+func (b *IssueQueryBuilder) ids(name string, dst *[]int, ids []int) *IssueQueryBuilder {
+	for _, id := range ids {
+		if id <= 0 {
+			b.invalid("%s must be positive, but got %d", name, id)
+			continue
+		}
+		*dst = append(*dst, id)
+	}
+	return b
+}
+
+// Project filters issues by project IDs
+func (b *IssueQueryBuilder) Project(ids ...int) *IssueQueryBuilder {
+	return b.ids("projectId", &b.opts.ProjectIDs, ids)
+}
+
+// IssueType filters issues by issue type IDs
+func (b *IssueQueryBuilder) IssueType(ids ...int) *IssueQueryBuilder {
+	return b.ids("issueTypeId", &b.opts.IssueTypeIDs, ids)
+}
+
+// Category filters issues by category IDs
+func (b *IssueQueryBuilder) Category(ids ...int) *IssueQueryBuilder {
+	return b.ids("categoryId", &b.opts.CategoryIDs, ids)
+}
+
+// Version filters issues by version IDs
+func (b *IssueQueryBuilder) Version(ids ...int) *IssueQueryBuilder {
+	return b.ids("versionId", &b.opts.VersionIDs, ids)
+}
+
+// Milestone filters issues by milestone IDs
+func (b *IssueQueryBuilder) Milestone(ids ...int) *IssueQueryBuilder {
+	return b.ids("milestoneId", &b.opts.MilestoneIDs, ids)
+}
+
+// Status filters issues by status IDs
+func (b *IssueQueryBuilder) Status(ids ...int) *IssueQueryBuilder {
+	return b.ids("statusId", &b.opts.StatusIDs, ids)
+}
+
+// Priority filters issues by priority IDs
+func (b *IssueQueryBuilder) Priority(ids ...int) *IssueQueryBuilder {
+	return b.ids("priorityId", &b.opts.PriorityIDs, ids)
+}
+
+// Assignee filters issues by assignee user IDs
+func (b *IssueQueryBuilder) Assignee(ids ...int) *IssueQueryBuilder {
+	return b.ids("assigneeId", &b.opts.AssigneeIDs, ids)
+}
+
+// CreatedUser filters issues by the IDs of users who created them
+func (b *IssueQueryBuilder) CreatedUser(ids ...int) *IssueQueryBuilder {
+	return b.ids("createdUserId", &b.opts.CreatedUserIDs, ids)
+}
+
+// Resolution filters issues by resolution IDs.
+// Resolution IDs start from 0, so only negative values are rejected.
+func (b *IssueQueryBuilder) Resolution(ids ...int) *IssueQueryBuilder {
+	for _, id := range ids {
+		if id < 0 {
+			b.invalid("resolutionId must not be negative, but got %d", id)
+			continue
+		}
+		b.opts.ResolutionIDs = append(b.opts.ResolutionIDs, id)
+	}
+	return b
+}
+
+// ID filters issues by issue IDs
+func (b *IssueQueryBuilder) ID(ids ...int) *IssueQueryBuilder {
+	return b.ids("id", &b.opts.IDs, ids)
+}
+
+// ParentIssue filters issues by parent issue IDs
+func (b *IssueQueryBuilder) ParentIssue(ids ...int) *IssueQueryBuilder {
+	return b.ids("parentIssueId", &b.opts.ParentIssueIDs, ids)
+}
+
+// ParentChild filters issues by parent/child relationship.
+//
+// 0: all, 1: exclude child issues, 2: child issues only,
+// 3: neither parent nor child issues, 4: parent issues only
+func (b *IssueQueryBuilder) ParentChild(v int) *IssueQueryBuilder {
+	if v < 0 || v > 4 {
+		b.invalid("parentChild must be between 0 and 4, but got %d", v)
+		return b
+	}
+	b.opts.ParentChild = Int(v)
+	return b
+}
+
+// Attachment filters issues by whether they have attachments
+func (b *IssueQueryBuilder) Attachment(v bool) *IssueQueryBuilder {
+	b.opts.Attachment = Bool(v)
+	return b
+}
+
+// SharedFile filters issues by whether they have shared files
+func (b *IssueQueryBuilder) SharedFile(v bool) *IssueQueryBuilder {
+	b.opts.SharedFile = Bool(v)
+	return b
+}
+
+// Keyword filters issues by keyword
+func (b *IssueQueryBuilder) Keyword(s string) *IssueQueryBuilder {
+	if strings.TrimSpace(s) == "" {
+		b.invalid("keyword must not be empty")
+		return b
+	}
+	b.opts.Keyword = String(s)
+	return b
+}
+
+// SortBy sets the sort key and the order
+func (b *IssueQueryBuilder) SortBy(sort Sort, order Order) *IssueQueryBuilder {
+	if sort.String() == "" {
+		b.invalid("unknown sort %q", string(sort))
+	} else {
+		b.opts.Sort = sort
+	}
+	if order.String() == "" {
+		b.invalid("unknown order %q", string(order))
+	} else {
+		b.opts.Order = order
+	}
+	return b
+}
+
+// Offset sets the offset of the result
+func (b *IssueQueryBuilder) Offset(n int) *IssueQueryBuilder {
+	if n < 0 {
+		b.invalid("offset must not be negative, but got %d", n)
+		return b
+	}
+	b.opts.Offset = Int(n)
+	return b
+}
+
+// Count sets the number of issues to return, between 1 and 100
+func (b *IssueQueryBuilder) Count(n int) *IssueQueryBuilder {
+	if n < 1 || n > maxIssueQueryCount {
+		b.invalid("count must be between 1 and %d, but got %d", maxIssueQueryCount, n)
+		return b
+	}
+	b.opts.Count = Int(n)
+	return b
+}
+
+func (b *IssueQueryBuilder) date(dst **string, t time.Time) *IssueQueryBuilder {
+	*dst = String(t.Format(issueQueryDateLayout))
+	return b
+}
+
+// CreatedSince filters issues created on or after the date of t
+func (b *IssueQueryBuilder) CreatedSince(t time.Time) *IssueQueryBuilder {
+	return b.date(&b.opts.CreatedSince, t)
+}
+
+// CreatedUntil filters issues created on or before the date of t
+func (b *IssueQueryBuilder) CreatedUntil(t time.Time) *IssueQueryBuilder {
+	return b.date(&b.opts.CreatedUntil, t)
+}
+
+// UpdatedSince filters issues updated on or after the date of t
+func (b *IssueQueryBuilder) UpdatedSince(t time.Time) *IssueQueryBuilder {
+	return b.date(&b.opts.UpdatedSince, t)
+}
+
+// UpdatedUntil filters issues updated on or before the date of t
+func (b *IssueQueryBuilder) UpdatedUntil(t time.Time) *IssueQueryBuilder {
+	return b.date(&b.opts.UpdatedUntil, t)
+}
+
+// StartDateSince filters issues whose start date is on or after the date of t
+func (b *IssueQueryBuilder) StartDateSince(t time.Time) *IssueQueryBuilder {
+	return b.date(&b.opts.StartDateSince, t)
+}
+
+// StartDateUntil filters issues whose start date is on or before the date of t
+func (b *IssueQueryBuilder) StartDateUntil(t time.Time) *IssueQueryBuilder {
+	return b.date(&b.opts.StartDateUntil, t)
+}
+
+// DueDateSince filters issues whose due date is on or after the date of t
+func (b *IssueQueryBuilder) DueDateSince(t time.Time) *IssueQueryBuilder {
+	return b.date(&b.opts.DueDateSince, t)
+}
+
+// DueDateUntil filters issues whose due date is on or before the date of t
+func (b *IssueQueryBuilder) DueDateUntil(t time.Time) *IssueQueryBuilder {
+	return b.date(&b.opts.DueDateUntil, t)
+}
+
+// DueBefore is an alias of DueDateUntil
+func (b *IssueQueryBuilder) DueBefore(t time.Time) *IssueQueryBuilder {
+	return b.DueDateUntil(t)
+}
+
+// DueAfter is an alias of DueDateSince
+func (b *IssueQueryBuilder) DueAfter(t time.Time) *IssueQueryBuilder {
+	return b.DueDateSince(t)
+}
+
+// validateRange returns the problem of a date range, or an empty string
+func validateRange(name string, since, until *string) string {
+	// dates share the same layout, so they can be compared as strings
+	if since != nil && until != nil && *since > *until {
+		return fmt.Sprintf("%sSince %s is after %sUntil %s", name, *since, name, *until)
+	}
+	return ""
+}
+
+// validate checks the ranges in a local slice so that building the query
+// more than once does not repeat the problems
+func (b *IssueQueryBuilder) validate() error {
+	problems := slices.Clone(b.problems)
+	for _, p := range []string{
+		validateRange("created", b.opts.CreatedSince, b.opts.CreatedUntil),
+		validateRange("updated", b.opts.UpdatedSince, b.opts.UpdatedUntil),
+		validateRange("startDate", b.opts.StartDateSince, b.opts.StartDateUntil),
+		validateRange("dueDate", b.opts.DueDateSince, b.opts.DueDateUntil),
+	} {
+		if p != "" {
+			problems = append(problems, p)
+		}
+	}
+	if len(problems) > 0 {
+		return &IssueQueryError{Problems: problems}
+	}
+	return nil
+}
+
+// options returns a copy of the options, not to share the slices with the builder
+func (b *IssueQueryBuilder) options() GetIssuesOptions {
+	opts := b.opts
+	opts.ProjectIDs = slices.Clone(opts.ProjectIDs)
+	opts.IssueTypeIDs = slices.Clone(opts.IssueTypeIDs)
+	opts.CategoryIDs = slices.Clone(opts.CategoryIDs)
+	opts.VersionIDs = slices.Clone(opts.VersionIDs)
+	opts.MilestoneIDs = slices.Clone(opts.MilestoneIDs)
+	opts.StatusIDs = slices.Clone(opts.StatusIDs)
+	opts.PriorityIDs = slices.Clone(opts.PriorityIDs)
+	opts.AssigneeIDs = slices.Clone(opts.AssigneeIDs)
+	opts.CreatedUserIDs = slices.Clone(opts.CreatedUserIDs)
+	opts.ResolutionIDs = slices.Clone(opts.ResolutionIDs)
+	opts.IDs = slices.Clone(opts.IDs)
+	opts.ParentIssueIDs = slices.Clone(opts.ParentIssueIDs)
+	return opts
+}
+
+// Build returns GetIssuesOptions for GetIssues
+func (b *IssueQueryBuilder) Build() (*GetIssuesOptions, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	opts := b.options()
+	return &opts, nil
+}
+
+// BuildCount returns GetIssuesCountOptions for GetIssueCount.
+// Sort, order, offset and count do not affect the number of issues and are left out.
+func (b *IssueQueryBuilder) BuildCount() (*GetIssuesCountOptions, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	opts := b.options()
+	return &GetIssuesCountOptions{
+		ProjectIDs:     opts.ProjectIDs,
+		IssueTypeIDs:   opts.IssueTypeIDs,
+		CategoryIDs:    opts.CategoryIDs,
+		VersionIDs:     opts.VersionIDs,
+		MilestoneIDs:   opts.MilestoneIDs,
+		StatusIDs:      opts.StatusIDs,
+		PriorityIDs:    opts.PriorityIDs,
+		AssigneeIDs:    opts.AssigneeIDs,
+		CreatedUserIDs: opts.CreatedUserIDs,
+		ResolutionIDs:  opts.ResolutionIDs,
+		ParentChild:    opts.ParentChild,
+		Attachment:     opts.Attachment,
+		SharedFile:     opts.SharedFile,
+		CreatedSince:   opts.CreatedSince,
+		CreatedUntil:   opts.CreatedUntil,
+		UpdatedSince:   opts.UpdatedSince,
+		UpdatedUntil:   opts.UpdatedUntil,
+		StartDateSince: opts.StartDateSince,
+		StartDateUntil: opts.StartDateUntil,
+		DueDateSince:   opts.DueDateSince,
+		DueDateUntil:   opts.DueDateUntil,
+		IDs:            opts.IDs,
+		ParentIssueIDs: opts.ParentIssueIDs,
+		Keyword:        opts.Keyword,
+	}, nil
+}
@@ -0,0 +1,212 @@
+package backlog
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueQuery_Build(t *testing.T) {
+	since := time.Date(2019, 1, 7, 10, 0, 0, 0, time.UTC)
+	until := time.Date(2020, 1, 7, 10, 0, 0, 0, time.UTC)
+
+	opts, err := IssueQuery().
+		Project(1).
+		IssueType(2).
+		Category(3).
+		Version(4).
+		Milestone(5).
+		Status(6, 7).
+		Priority(8).
+		Assignee(9).
+		CreatedUser(10).
+		Resolution(0).
+		ID(11).
+		ParentIssue(12).
+		ParentChild(1).
+		Attachment(true).
+		SharedFile(false).
+		Keyword("test").
+		SortBy(SortDueDate, OrderAsc).
+		Offset(0).
+		Count(100).
+		CreatedSince(since).
+		CreatedUntil(until).
+		UpdatedSince(since).
+		UpdatedUntil(until).
+		StartDateSince(since).
+		StartDateUntil(until).
+		DueAfter(since).
+		DueBefore(until).
+		Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := &GetIssuesOptions{
+		ProjectIDs:     []int{1},
+		IssueTypeIDs:   []int{2},
+		CategoryIDs:    []int{3},
+		VersionIDs:     []int{4},
+		MilestoneIDs:   []int{5},
+		StatusIDs:      []int{6, 7},
+		PriorityIDs:    []int{8},
+		AssigneeIDs:    []int{9},
+		CreatedUserIDs: []int{10},
+		ResolutionIDs:  []int{0},
+		IDs:            []int{11},
+		ParentIssueIDs: []int{12},
+		ParentChild:    Int(1),
+		Attachment:     Bool(true),
+		SharedFile:     Bool(false),
+		Keyword:        String("test"),
+		Sort:           SortDueDate,
+		Order:          OrderAsc,
+		Offset:         Int(0),
+		Count:          Int(100),
+		CreatedSince:   String("2019-01-07"),
+		CreatedUntil:   String("2020-01-07"),
+		UpdatedSince:   String("2019-01-07"),
+		UpdatedUntil:   String("2020-01-07"),
+		StartDateSince: String("2019-01-07"),
+		StartDateUntil: String("2020-01-07"),
+		DueDateSince:   String("2019-01-07"),
+		DueDateUntil:   String("2020-01-07"),
+	}
+	if !reflect.DeepEqual(want, opts) {
+		t.Fatal(ErrIncorrectResponse, errors.New(pretty.Compare(want, opts)))
+	}
+}
+
+func TestIssueQuery_BuildCount(t *testing.T) {
+	b := IssueQuery().
+		Project(1).
+		Status(1, 2).
+		Keyword("test").
+		SortBy(SortCreated, OrderDesc).
+		Count(20)
+
+	opts, err := b.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	countOpts, err := b.BuildCount()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Equal(t, opts.ProjectIDs, countOpts.ProjectIDs)
+	assert.Equal(t, opts.StatusIDs, countOpts.StatusIDs)
+	assert.Equal(t, opts.Keyword, countOpts.Keyword)
+	assert.Equal(t, Sort(""), countOpts.Sort)
+	assert.Nil(t, countOpts.Count)
+
+	// the options built are not changed by the builder afterwards
+	b.Status(3)
+	assert.Equal(t, []int{1, 2}, opts.StatusIDs)
+	assert.Equal(t, []int{1, 2}, countOpts.StatusIDs)
+}
+
+func TestIssueQuery_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *IssueQueryBuilder
+		want    []string
+	}{
+		{
+			name:    "count",
+			builder: IssueQuery().Count(101),
+			want:    []string{"count must be between 1 and 100, but got 101"},
+		},
+		{
+			name:    "offset",
+			builder: IssueQuery().Offset(-1),
+			want:    []string{"offset must not be negative, but got -1"},
+		},
+		{
+			name:    "sort",
+			builder: IssueQuery().SortBy(Sort("unknown"), Order("unknown")),
+			want:    []string{`unknown sort "unknown"`, `unknown order "unknown"`},
+		},
+		{
+			name:    "ids",
+			builder: IssueQuery().Project(0).Resolution(-1),
+			want:    []string{"projectId must be positive, but got 0", "resolutionId must not be negative, but got -1"},
+		},
+		{
+			name:    "parentChild",
+			builder: IssueQuery().ParentChild(5),
+			want:    []string{"parentChild must be between 0 and 4, but got 5"},
+		},
+		{
+			name:    "keyword",
+			builder: IssueQuery().Keyword(" "),
+			want:    []string{"keyword must not be empty"},
+		},
+		{
+			name: "range",
+			builder: IssueQuery().
+				DueDateSince(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)).
+				DueDateUntil(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			want: []string{"dueDateSince 2020-01-02 is after dueDateUntil 2020-01-01"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			var qerr *IssueQueryError
+			if !errors.As(err, &qerr) {
+				t.Fatalf("expected IssueQueryError but got %v", err)
+			}
+			assert.Equal(t, tt.want, qerr.Problems)
+
+			// building again does not repeat the problems
+			_, err = tt.builder.BuildCount()
+			if !errors.As(err, &qerr) {
+				t.Fatalf("expected IssueQueryError but got %v", err)
+			}
+			assert.Equal(t, tt.want, qerr.Problems)
+		})
+	}
+}
+
+func TestIssueQuery_GetIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("projectId[]"))
+		assert.Equal(t, "dueDate", r.URL.Query().Get("sort"))
+		assert.Equal(t, "asc", r.URL.Query().Get("order"))
+		assert.Equal(t, "2020-01-07", r.URL.Query().Get("dueDateUntil"))
+		j := fmt.Sprintf("[%s]", testJSONIssue)
+		if _, err := fmt.Fprint(w, j); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	opts, err := IssueQuery().
+		Project(1).
+		DueBefore(time.Date(2020, 1, 7, 0, 0, 0, 0, time.UTC)).
+		SortBy(SortDueDate, OrderAsc).
+		Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	issues, err := client.GetIssues(opts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := []*Issue{getTestIssue()}
+	if !reflect.DeepEqual(want, issues) {
+		t.Fatal(ErrIncorrectResponse)
+	}
+}
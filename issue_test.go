@@ -818,14 +818,17 @@ func TestGetIssueComments(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	mux.HandleFunc("/issues/BLG-1/comments", func(w http.ResponseWriter, _ *http.Request) {
+	mux.HandleFunc("/issues/BLG-1/comments", func(w http.ResponseWriter, r *http.Request) {
+		if q := r.URL.Query(); q.Get("minId") != "10" || q.Get("order") != "asc" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
 		j := fmt.Sprintf(`[%s]`, testJSONIssueComment)
 		if _, err := fmt.Fprint(w, j); err != nil {
 			t.Fatal(err)
 		}
 	})
 
-	expected, err := client.GetIssueComments("BLG-1", &GetIssueCommentsOptions{})
+	expected, err := client.GetIssueComments("BLG-1", &GetIssueCommentsOptions{MinID: Int(10), Order: OrderAsc})
 	if err != nil {
 		t.Errorf("Unexpected error: %s", err)
 		return
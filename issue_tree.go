@@ -0,0 +1,154 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IssueTree : an issue with its child issues
+type IssueTree struct {
+	Issue    *Issue
+	Children []*IssueTree
+}
+
+// IssueTreeRollup : the values rolled up over an issue and its descendants.
+// Dates are formatted as yyyy-MM-dd and empty if no issue has them.
+type IssueTreeRollup struct {
+	EstimatedHours    float64
+	ActualHours       float64
+	Children          int // the number of descendants
+	CompletedChildren int // the number of closed descendants
+	StartDate         string
+	DueDate           string
+}
+
+// GetIssueTree returns an issue with its descendants
+func (c *Client) GetIssueTree(issueKey string) (*IssueTree, error) {
+	return c.GetIssueTreeContext(context.Background(), issueKey)
+}
+
+// GetIssueTreeContext returns an issue with its descendants with context
+func (c *Client) GetIssueTreeContext(ctx context.Context, issueKey string, callOpts ...CallOption) (*IssueTree, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	issue, err := c.GetIssueContext(ctx, issueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &IssueTree{Issue: issue}
+	seen := map[int]bool{*issue.ID: true}
+	level := map[int]*IssueTree{*issue.ID: root}
+	for len(level) > 0 {
+		ids := make([]int, 0, len(level))
+		for id := range level {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		next := map[int]*IssueTree{}
+		for len(ids) > 0 {
+			n := min(len(ids), maxIssueQueryCount)
+			children, err := c.getAllIssuesContext(ctx, &GetIssuesOptions{
+				ParentIssueIDs: ids[:n],
+				Sort:           SortCreated,
+				Order:          OrderAsc,
+			})
+			if err != nil {
+				return nil, err
+			}
+			ids = ids[n:]
+
+			for _, child := range children {
+				// guard against cycles
+				if seen[*child.ID] || child.ParentIssueID == nil {
+					continue
+				}
+				parent, ok := level[*child.ParentIssueID]
+				if !ok {
+					continue
+				}
+				seen[*child.ID] = true
+				node := &IssueTree{Issue: child}
+				parent.Children = append(parent.Children, node)
+				next[*child.ID] = node
+			}
+		}
+		level = next
+	}
+	return root, nil
+}
+
+// Walk calls fn for the issue and its descendants in depth-first order
+func (t *IssueTree) Walk(fn func(node *IssueTree, depth int)) {
+	t.walk(fn, 0)
+}
+
+func (t *IssueTree) walk(fn func(node *IssueTree, depth int), depth int) {
+	fn(t, depth)
+	for _, child := range t.Children {
+		child.walk(fn, depth+1)
+	}
+}
+
+// Rollup returns the values rolled up over the issue and its descendants
+func (t *IssueTree) Rollup() *IssueTreeRollup {
+	r := &IssueTreeRollup{}
+	t.Walk(func(node *IssueTree, depth int) {
+		issue := node.Issue
+		if issue.EstimatedHours != nil {
+			r.EstimatedHours += *issue.EstimatedHours
+		}
+		if issue.ActualHours != nil {
+			r.ActualHours += *issue.ActualHours
+		}
+		if start := issueDate(issue.StartDate); start != "" && (r.StartDate == "" || start < r.StartDate) {
+			r.StartDate = start
+		}
+		if due := issueDate(issue.DueDate); due != "" && due > r.DueDate {
+			r.DueDate = due
+		}
+		if depth == 0 {
+			return
+		}
+		r.Children++
+		if issue.StatusType() == StatusClosed {
+			r.CompletedChildren++
+		}
+	})
+	return r
+}
+
+// Text renders the tree as text indented by two spaces per level
+func (t *IssueTree) Text() string {
+	var b strings.Builder
+	t.Walk(func(node *IssueTree, depth int) {
+		b.WriteString(strings.Repeat("  ", depth))
+		b.WriteString(issueTreeLabel(node.Issue))
+		if node.Issue.Status != nil {
+			fmt.Fprintf(&b, " [%s]", derefString(node.Issue.Status.Name))
+		}
+		b.WriteString("\n")
+	})
+	return b.String()
+}
+
+// DOT renders the tree in the Graphviz DOT language
+func (t *IssueTree) DOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(derefString(t.Issue.IssueKey)))
+	t.Walk(func(node *IssueTree, _ int) {
+		key := strconv.Quote(derefString(node.Issue.IssueKey))
+		fmt.Fprintf(&b, "  %s [label=%s];\n", key, strconv.Quote(issueTreeLabel(node.Issue)))
+		for _, child := range node.Children {
+			fmt.Fprintf(&b, "  %s -> %s;\n", key, strconv.Quote(derefString(child.Issue.IssueKey)))
+		}
+	})
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func issueTreeLabel(issue *Issue) string {
+	return fmt.Sprintf("%s %s", derefString(issue.IssueKey), derefString(issue.Summary))
+}
@@ -0,0 +1,89 @@
+package backlog
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueTree(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `{
+			"id": 1, "issueKey": "BLG-1", "summary": "epic",
+			"status": {"id": 2, "name": "処理中"},
+			"estimatedHours": 1
+		}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, r *http.Request) {
+		var body string
+		switch r.URL.Query()["parentIssueId[]"][0] {
+		case "1":
+			body = `[
+				{"id": 2, "issueKey": "BLG-2", "summary": "done", "parentIssueId": 1,
+				 "status": {"id": 4, "name": "完了"}, "estimatedHours": 2, "actualHours": 3,
+				 "startDate": "2019-01-07T00:00:00Z", "dueDate": "2019-01-10T00:00:00Z"},
+				{"id": 3, "issueKey": "BLG-3", "summary": "todo \"quoted\"", "parentIssueId": 1,
+				 "status": {"id": 1, "name": "未対応"}, "estimatedHours": 0.5,
+				 "startDate": "2019-01-08T00:00:00Z", "dueDate": "2019-01-20T00:00:00Z"}
+			]`
+		case "2":
+			// a cycle back to the root is ignored
+			body = `[{"id": 1, "issueKey": "BLG-1", "parentIssueId": 2}]`
+		default:
+			t.Fatalf("unexpected parent %v", r.URL.Query()["parentIssueId[]"])
+		}
+		if _, err := fmt.Fprint(w, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	tree, err := client.GetIssueTree("BLG-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Len(t, tree.Children, 2)
+
+	assert.Equal(t, &IssueTreeRollup{
+		EstimatedHours:    3.5,
+		ActualHours:       3,
+		Children:          2,
+		CompletedChildren: 1,
+		StartDate:         "2019-01-07",
+		DueDate:           "2019-01-20",
+	}, tree.Rollup())
+
+	assert.Equal(t, "BLG-1 epic [処理中]\n  BLG-2 done [完了]\n  BLG-3 todo \"quoted\" [未対応]\n", tree.Text())
+	assert.Equal(t, `digraph "BLG-1" {
+  "BLG-1" [label="BLG-1 epic"];
+  "BLG-1" -> "BLG-2";
+  "BLG-1" -> "BLG-3";
+  "BLG-2" [label="BLG-2 done"];
+  "BLG-3" [label="BLG-3 todo \"quoted\""];
+}
+`, tree.DOT())
+}
+
+func TestGetIssueTreeFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `{"id": 1, "issueKey": "BLG-1"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/issues", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := client.GetIssueTree("BLG-1"); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
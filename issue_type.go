@@ -22,7 +22,8 @@ func (c *Client) GetIssueTypes(projectIDOrKey interface{}) ([]*IssueType, error)
 }
 
 // GetIssueTypesContext returns the list of categories with context
-func (c *Client) GetIssueTypesContext(ctx context.Context, projectIDOrKey interface{}) ([]*IssueType, error) {
+func (c *Client) GetIssueTypesContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) ([]*IssueType, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/issueTypes", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -43,7 +44,8 @@ func (c *Client) CreateIssueType(projectIDOrKey interface{}, input *CreateIssueT
 }
 
 // CreateIssueTypeContext creates an issue type with Context
-func (c *Client) CreateIssueTypeContext(ctx context.Context, projectIDOrKey interface{}, input *CreateIssueTypeInput) (*IssueType, error) {
+func (c *Client) CreateIssueTypeContext(ctx context.Context, projectIDOrKey interface{}, input *CreateIssueTypeInput, callOpts ...CallOption) (*IssueType, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/issueTypes", projectIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -64,7 +66,8 @@ func (c *Client) UpdateIssueType(projectIDOrKey interface{}, issueTypeID int, in
 }
 
 // UpdateIssueTypeContext updates an issue type with Context
-func (c *Client) UpdateIssueTypeContext(ctx context.Context, projectIDOrKey interface{}, issueTypeID int, input *UpdateIssueTypeInput) (*IssueType, error) {
+func (c *Client) UpdateIssueTypeContext(ctx context.Context, projectIDOrKey interface{}, issueTypeID int, input *UpdateIssueTypeInput, callOpts ...CallOption) (*IssueType, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/issueTypes/%v", projectIDOrKey, issueTypeID)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -85,7 +88,8 @@ func (c *Client) DeleteIssueType(projectIDOrKey interface{}, issueTypeID int, in
 }
 
 // DeleteIssueTypeContext deletes an issue type with Context
-func (c *Client) DeleteIssueTypeContext(ctx context.Context, projectIDOrKey interface{}, issueTypeID int, input *DeleteIssueTypeInput) (*IssueType, error) {
+func (c *Client) DeleteIssueTypeContext(ctx context.Context, projectIDOrKey interface{}, issueTypeID int, input *DeleteIssueTypeInput, callOpts ...CallOption) (*IssueType, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/issueTypes/%v", projectIDOrKey, issueTypeID)
 
 	req, err := c.NewRequest("DELETE", u, input)
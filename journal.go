@@ -0,0 +1,380 @@
+package backlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// JournalKind : the kind of resources recorded in a journal
+type JournalKind string
+
+// JournalKind is one of these values
+const (
+	JournalKindIssue                = JournalKind("issue")
+	JournalKindWiki                 = JournalKind("wiki")
+	JournalKindStatus               = JournalKind("status")
+	JournalKindCategory             = JournalKind("category")
+	JournalKindVersion              = JournalKind("version")
+	JournalKindProjectUser          = JournalKind("projectUser")
+	JournalKindProjectAdministrator = JournalKind("projectAdministrator")
+)
+
+// JournalEntry : a mutating request recorded in a journal.
+// Previous is the state of the resource before the request; for statuses,
+// categories, versions and members it is the list in the project.
+type JournalEntry struct {
+	Time     time.Time       `json:"time"`
+	Kind     JournalKind     `json:"kind"`
+	Method   string          `json:"method"`
+	Path     string          `json:"path"`
+	Request  json.RawMessage `json:"request,omitempty"`
+	Previous json.RawMessage `json:"previous,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// journalRule : the requests recorded as a kind, and the path to get the
+// previous state formatted with the first submatch of the pattern
+type journalRule struct {
+	kind     JournalKind
+	methods  []string
+	pattern  *regexp.Regexp
+	snapshot string
+}
+
+var journalRules = []*journalRule{
+	{JournalKindIssue, []string{"PATCH", "DELETE"}, regexp.MustCompile(`^/api/v2/issues/([^/]+)$`), "/api/v2/issues/%s"},
+	{JournalKindWiki, []string{"PATCH", "DELETE"}, regexp.MustCompile(`^/api/v2/wikis/(\d+)$`), "/api/v2/wikis/%s"},
+	{JournalKindStatus, []string{"PATCH", "DELETE"}, regexp.MustCompile(`^/api/v2/projects/([^/]+)/statuses/(\d+)$`), "/api/v2/projects/%s/statuses"},
+	{JournalKindCategory, []string{"PATCH", "DELETE"}, regexp.MustCompile(`^/api/v2/projects/([^/]+)/categories/(\d+)$`), "/api/v2/projects/%s/categories"},
+	{JournalKindVersion, []string{"PATCH", "DELETE"}, regexp.MustCompile(`^/api/v2/projects/([^/]+)/versions/(\d+)$`), "/api/v2/projects/%s/versions"},
+	{JournalKindProjectUser, []string{"POST", "DELETE"}, regexp.MustCompile(`^/api/v2/projects/([^/]+)/users$`), "/api/v2/projects/%s/users"},
+	{JournalKindProjectAdministrator, []string{"POST", "DELETE"}, regexp.MustCompile(`^/api/v2/projects/([^/]+)/administrators$`), "/api/v2/projects/%s/administrators"},
+}
+
+// matchJournalRule returns the rule of a request and the submatches of the path
+func matchJournalRule(method, path string) (*journalRule, []string) {
+	for _, rule := range journalRules {
+		m := rule.pattern.FindStringSubmatch(path)
+		if m == nil {
+			continue
+		}
+		for _, v := range rule.methods {
+			if v == method {
+				return rule, m
+			}
+		}
+	}
+	return nil, nil
+}
+
+// Journal : a JSON Lines file of mutating requests
+type Journal struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// OpenJournal opens a journal file to append entries, creating it if needed
+func OpenJournal(path string) (*Journal, error) {
+	file, err := os.OpenFile(filepath.Clean(path), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &Journal{path: path, file: file}, nil
+}
+
+// Close closes the journal file
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.file.Close()
+}
+
+// Entries returns the entries in the journal file in the order they were recorded
+func (j *Journal) Entries() (entries []*JournalEntry, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	file, err := os.Open(filepath.Clean(j.path))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if er := file.Close(); er != nil && err == nil {
+			err = er
+		}
+	}()
+
+	dec := json.NewDecoder(file)
+	for {
+		entry := new(JournalEntry)
+		if err := dec.Decode(entry); err == io.EOF {
+			return entries, nil
+		} else if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func (j *Journal) append(entry *JournalEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	_, err = j.file.Write(append(b, '\n'))
+	return err
+}
+
+// doJournaled sends a mutating request, recording it with the previous state to the journal
+func (c *Client) doJournaled(ctx context.Context, req *http.Request, v interface{}) error {
+	path := strings.TrimPrefix(req.URL.Path, c.baseURL.Path)
+	rule, m := matchJournalRule(req.Method, path)
+	if rule == nil {
+		return c.do(ctx, req, v)
+	}
+
+	entry := &JournalEntry{Time: time.Now(), Kind: rule.kind, Method: req.Method, Path: path}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		if b = bytes.TrimSpace(b); len(b) > 0 {
+			entry.Request = b
+		}
+	}
+
+	sreq, err := c.NewRequest("GET", fmt.Sprintf(rule.snapshot, m[1]), nil)
+	if err != nil {
+		return err
+	}
+	if err := c.do(ctx, sreq, &entry.Previous); err != nil {
+		return errors.Wrap(err, "failed to record the previous state")
+	}
+
+	err = c.do(ctx, req, v)
+	if err != nil {
+		entry.Error = err.Error()
+	} else if _, ok := v.(io.Writer); !ok && v != nil {
+		if b, er := json.Marshal(v); er == nil {
+			entry.Response = b
+		}
+	}
+	if er := c.journal.append(entry); er != nil && err == nil {
+		err = errors.Wrap(er, "failed to write the journal")
+	}
+	return err
+}
+
+// Undo restores the state before a journal entry.
+// Deleted issues cannot be restored, and deleted wikis, statuses,
+// categories and versions are created again with new IDs. Fields of an
+// issue which cannot be cleared, such as categories added by the entry, are
+// left as they are with an error naming them.
+func (c *Client) Undo(entry *JournalEntry) error {
+	return c.UndoContext(context.Background(), entry)
+}
+
+// UndoContext restores the state before a journal entry with context.
+func (c *Client) UndoContext(ctx context.Context, entry *JournalEntry, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+	rule, m := matchJournalRule(entry.Method, entry.Path)
+	if rule == nil || rule.kind != entry.Kind {
+		return errors.Errorf("unknown journal entry %s %s", entry.Method, entry.Path)
+	}
+	if entry.Error != "" {
+		// the request failed, so there is nothing to undo
+		return nil
+	}
+	deleted := entry.Method == "DELETE"
+	var id int
+	if len(m) > 2 {
+		id, _ = strconv.Atoi(m[2])
+	}
+
+	switch entry.Kind {
+	case JournalKindIssue:
+		if deleted {
+			return errors.Errorf("undo of %s %s is not supported", entry.Method, entry.Path)
+		}
+		previous := new(Issue)
+		if err := json.Unmarshal(entry.Previous, previous); err != nil {
+			return err
+		}
+		current, err := c.GetIssueContext(ctx, m[1])
+		if err != nil {
+			return err
+		}
+		diff := DiffIssues(current, previous)
+		if len(diff) == 0 {
+			return nil
+		}
+		input, unapplied := diff.UpdateIssueInput()
+		if _, err := c.UpdateIssueContext(ctx, m[1], input); err != nil {
+			return err
+		}
+		if len(unapplied) > 0 {
+			fields := make([]string, len(unapplied))
+			for i, f := range unapplied {
+				fields[i] = string(f.Field)
+			}
+			return errors.Errorf("%s cannot be cleared to restore issue %s", strings.Join(fields, ", "), m[1])
+		}
+		return nil
+
+	case JournalKindWiki:
+		previous := new(Wiki)
+		if err := json.Unmarshal(entry.Previous, previous); err != nil {
+			return err
+		}
+		var err error
+		if deleted {
+			_, err = c.CreateWikiContext(ctx, &CreateWikiInput{
+				ProjectID: previous.ProjectID,
+				Name:      previous.Name,
+				Content:   previous.Content,
+			})
+		} else {
+			_, err = c.UpdateWikiContext(ctx, *previous.ID, &UpdateWikiInput{
+				Name:    previous.Name,
+				Content: previous.Content,
+			})
+		}
+		return err
+
+	case JournalKindStatus:
+		previous, err := journalPreviousItem(entry, id, func(v *Status) *int { return v.ID })
+		if err != nil {
+			return err
+		}
+		if deleted {
+			_, err = c.CreateStatusContext(ctx, m[1], &CreateStatusInput{Name: previous.Name, Color: previous.Color})
+		} else {
+			_, err = c.UpdateStatusContext(ctx, m[1], id, &UpdateStatusInput{Name: previous.Name, Color: previous.Color})
+		}
+		return err
+
+	case JournalKindCategory:
+		previous, err := journalPreviousItem(entry, id, func(v *Category) *int { return v.ID })
+		if err != nil {
+			return err
+		}
+		if deleted {
+			_, err = c.CreateCategoryContext(ctx, m[1], &CreateCategoryInput{Name: previous.Name})
+		} else {
+			_, err = c.UpdateCategoryContext(ctx, m[1], id, &UpdateCategoryInput{Name: previous.Name})
+		}
+		return err
+
+	case JournalKindVersion:
+		previous, err := journalPreviousItem(entry, id, func(v *Version) *int { return v.ID })
+		if err != nil {
+			return err
+		}
+		var startDate, releaseDueDate *string
+		if previous.StartDate != nil {
+			startDate = String(issueDate(previous.StartDate))
+		}
+		if previous.ReleaseDueDate != nil {
+			releaseDueDate = String(issueDate(previous.ReleaseDueDate))
+		}
+		if deleted {
+			_, err = c.CreateVersionContext(ctx, m[1], &CreateVersionInput{
+				Name:           previous.Name,
+				Description:    previous.Description,
+				StartDate:      startDate,
+				ReleaseDueDate: releaseDueDate,
+			})
+		} else {
+			_, err = c.UpdateVersionContext(ctx, m[1], id, &UpdateVersionInput{
+				Name:           previous.Name,
+				Description:    previous.Description,
+				StartDate:      startDate,
+				ReleaseDueDate: releaseDueDate,
+				Archived:       previous.Archived,
+			})
+		}
+		return err
+
+	case JournalKindProjectUser, JournalKindProjectAdministrator:
+		var input struct {
+			UserID *int `json:"userId"`
+		}
+		if err := json.Unmarshal(entry.Request, &input); err != nil {
+			return err
+		}
+		var err error
+		switch {
+		case entry.Kind == JournalKindProjectUser && deleted:
+			_, err = c.AddProjectUserContext(ctx, m[1], &AddProjectUserInput{UserID: input.UserID})
+		case entry.Kind == JournalKindProjectUser:
+			_, err = c.DeleteProjectUserContext(ctx, m[1], &DeleteProjectUserInput{UserID: input.UserID})
+		case deleted:
+			_, err = c.AddProjectAdministratorContext(ctx, m[1], &AddProjectAdministratorInput{UserID: input.UserID})
+		default:
+			_, err = c.DeleteProjectAdministratorContext(ctx, m[1], &DeleteProjectAdministratorInput{UserID: input.UserID})
+		}
+		return err
+	}
+	return errors.Errorf("undo of %s %s is not supported", entry.Method, entry.Path)
+}
+
+// UndoSince restores the state before the entries recorded at or after t
+// in the journal of the client, from the newest one
+func (c *Client) UndoSince(t time.Time) error {
+	return c.UndoSinceContext(context.Background(), t)
+}
+
+// UndoSinceContext restores the state before the entries recorded at or after t
+// in the journal of the client, from the newest one with context
+func (c *Client) UndoSinceContext(ctx context.Context, t time.Time, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+	if c.journal == nil {
+		return errors.New("journal is not enabled")
+	}
+	entries, err := c.journal.Entries()
+	if err != nil {
+		return err
+	}
+	for i := len(entries) - 1; i >= 0 && !entries[i].Time.Before(t); i-- {
+		if err := c.UndoContext(ctx, entries[i]); err != nil {
+			return errors.Wrapf(err, "failed to undo %s %s", entries[i].Method, entries[i].Path)
+		}
+	}
+	return nil
+}
+
+// journalPreviousItem returns the item of id in the previous list of an entry
+func journalPreviousItem[T any](entry *JournalEntry, id int, idOf func(*T) *int) (*T, error) {
+	var items []*T
+	if err := json.Unmarshal(entry.Previous, &items); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if v := idOf(item); v != nil && *v == id {
+			return item, nil
+		}
+	}
+	return nil, errors.Errorf("previous state of %s %d is not recorded", entry.Kind, id)
+}
@@ -0,0 +1,153 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournal(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	journal, err := OpenJournal(filepath.Join(t.TempDir(), "journal.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := journal.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	OptionJournal(journal)(client)
+
+	summary := "old"
+	var requests []string
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			var input map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+			requests = append(requests, fmt.Sprintf("PATCH issue %v", input))
+			summary = input["summary"].(string)
+		}
+		if _, err := fmt.Fprintf(w, `{"id": 1, "issueKey": "BLG-1", "summary": %q}`, summary); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/projects/TEST/categories", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			var input map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+			requests = append(requests, fmt.Sprintf("POST category %v", input))
+			if _, err := fmt.Fprint(w, `{"id": 11, "name": "Backend"}`); err != nil {
+				t.Fatal(err)
+			}
+			return
+		}
+		if _, err := fmt.Fprint(w, `[{"id": 10, "name": "Backend"}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/projects/TEST/categories/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if _, err := fmt.Fprint(w, `{"id": 10, "name": "Backend"}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/projects/TEST/users", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			var input map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+			requests = append(requests, fmt.Sprintf("%s user %v", r.Method, input))
+			if _, err := fmt.Fprint(w, `{"id": 5}`); err != nil {
+				t.Fatal(err)
+			}
+			return
+		}
+		if _, err := fmt.Fprint(w, `[]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	start := time.Now()
+	_, err = client.UpdateIssue("BLG-1", &UpdateIssueInput{Summary: String("new")})
+	assert.NoError(t, err)
+	_, err = client.DeleteCategory("TEST", 10)
+	assert.NoError(t, err)
+	_, err = client.AddProjectUser("TEST", &AddProjectUserInput{UserID: Int(5)})
+	assert.NoError(t, err)
+
+	entries, err := journal.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, entries, 3)
+	assert.Equal(t, JournalKindIssue, entries[0].Kind)
+	assert.Equal(t, "/api/v2/issues/BLG-1", entries[0].Path)
+	assert.JSONEq(t, `{"summary": "new"}`, string(entries[0].Request))
+	assert.JSONEq(t, `{"id": 1, "issueKey": "BLG-1", "summary": "old"}`, string(entries[0].Previous))
+	assert.Equal(t, "new", *mustDecodeIssue(t, entries[0].Response).Summary)
+	assert.Equal(t, JournalKindCategory, entries[1].Kind)
+	assert.Equal(t, JournalKindProjectUser, entries[2].Kind)
+
+	requests = nil
+	if err := client.UndoSince(start); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	assert.Equal(t, []string{
+		"DELETE user map[userId:5]",
+		"POST category map[name:Backend]",
+		"PATCH issue map[summary:old]",
+	}, requests)
+	assert.Equal(t, "old", summary)
+
+	// a deleted issue cannot be restored
+	assert.Error(t, client.Undo(&JournalEntry{Kind: JournalKindIssue, Method: "DELETE", Path: "/api/v2/issues/BLG-1"}))
+}
+
+func TestUndoIssueCategoryAdded(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var patched map[string]interface{}
+	mux.HandleFunc("/issues/BLG-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := fmt.Fprint(w, `{"id": 1, "issueKey": "BLG-1", "summary": "new", "category": [{"id": 10, "name": "Backend"}]}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// the entry set the summary and added a category, which cannot be removed by an update
+	err := client.Undo(&JournalEntry{
+		Kind:     JournalKindIssue,
+		Method:   "PATCH",
+		Path:     "/api/v2/issues/BLG-1",
+		Previous: json.RawMessage(`{"id": 1, "issueKey": "BLG-1", "summary": "old", "category": []}`),
+	})
+	assert.EqualError(t, err, "category cannot be cleared to restore issue BLG-1")
+	assert.Equal(t, map[string]interface{}{"summary": "old"}, patched)
+}
+
+func mustDecodeIssue(t *testing.T, b []byte) *Issue {
+	t.Helper()
+	issue := new(Issue)
+	if err := json.Unmarshal(b, issue); err != nil {
+		t.Fatal(err)
+	}
+	return issue
+}
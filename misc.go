@@ -41,9 +41,14 @@ func (t ErrorResponse) Errs() error {
 type statusCodeError struct {
 	Code   int
 	Status string
+	// Err is the error of the error response, if any
+	Err error
 }
 
 func (t statusCodeError) Error() string {
+	if t.Err != nil {
+		return t.Err.Error()
+	}
 	return fmt.Sprintf("backlog server error: %s", t.Status)
 }
 
@@ -51,6 +56,16 @@ func (t statusCodeError) HTTPStatusCode() int {
 	return t.Code
 }
 
+func (t statusCodeError) Unwrap() error {
+	return t.Err
+}
+
+// isNotFound returns if err is caused by a response of 404 Not Found
+func isNotFound(err error) bool {
+	var e statusCodeError
+	return errors.As(err, &e) && e.Code == http.StatusNotFound
+}
+
 func checkStatusCode(resp *http.Response, d debug) error {
 	// return no error if response returns status code 2xx
 	if resp.StatusCode/100 == 2 {
@@ -63,7 +78,10 @@ func checkStatusCode(resp *http.Response, d debug) error {
 
 	errorResponse := new(ErrorResponse)
 	if err := newJSONParser(errorResponse)(resp); err == nil {
-		return errorResponse.Errs()
+		if err := errorResponse.Errs(); err != nil {
+			return statusCodeError{Code: resp.StatusCode, Status: resp.Status, Err: err}
+		}
+		return nil
 	}
 
 	return statusCodeError{Code: resp.StatusCode, Status: resp.Status}
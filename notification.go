@@ -0,0 +1,199 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// NotificationReason : the reason why a notification is sent
+type NotificationReason int
+
+// NotificationReason
+const (
+	NotificationReasonAssigned                  = NotificationReason(1)
+	NotificationReasonCommented                 = NotificationReason(2)
+	NotificationReasonIssueCreated              = NotificationReason(3)
+	NotificationReasonIssueUpdated              = NotificationReason(4)
+	NotificationReasonFileAdded                 = NotificationReason(5)
+	NotificationReasonProjectUserAdded          = NotificationReason(6)
+	NotificationReasonOther                     = NotificationReason(9)
+	NotificationReasonAssignedToPullRequest     = NotificationReason(10)
+	NotificationReasonCommentAddedOnPullRequest = NotificationReason(11)
+	NotificationReasonPullRequestAdded          = NotificationReason(12)
+	NotificationReasonPullRequestUpdated        = NotificationReason(13)
+)
+
+func (k NotificationReason) String() string {
+	return builtinName(k, "NotificationReason", notificationReasonNames, 0)
+}
+
+// Japanese returns the Japanese name of the reason
+func (k NotificationReason) Japanese() string {
+	return builtinName(k, "NotificationReason", notificationReasonNames, 1)
+}
+
+var notificationReasonNames = map[NotificationReason][2]string{
+	NotificationReasonAssigned:                  {"assigned", "担当者に設定"},
+	NotificationReasonCommented:                 {"commented", "コメント"},
+	NotificationReasonIssueCreated:              {"issue created", "課題の追加"},
+	NotificationReasonIssueUpdated:              {"issue updated", "課題の更新"},
+	NotificationReasonFileAdded:                 {"file added", "ファイルを追加"},
+	NotificationReasonProjectUserAdded:          {"project user added", "プロジェクトユーザーを追加"},
+	NotificationReasonOther:                     {"other", "その他"},
+	NotificationReasonAssignedToPullRequest:     {"assigned to pull request", "プルリクエストの担当者に設定"},
+	NotificationReasonCommentAddedOnPullRequest: {"comment added on pull request", "プルリクエストにコメント"},
+	NotificationReasonPullRequestAdded:          {"pull request added", "プルリクエストの追加"},
+	NotificationReasonPullRequestUpdated:        {"pull request updated", "プルリクエストの更新"},
+}
+
+// ReasonType returns Reason as NotificationReason
+func (n *Notification) ReasonType() NotificationReason {
+	if n == nil || n.Reason == nil {
+		return 0
+	}
+	return NotificationReason(*n.Reason)
+}
+
+// maxNotificationCount is the maximum count of notifications in a request
+const maxNotificationCount = 100
+
+// GetNotifications returns the list of my notifications
+func (c *Client) GetNotifications(opts *GetNotificationsOptions) ([]*Notification, error) {
+	return c.GetNotificationsContext(context.Background(), opts)
+}
+
+// GetNotificationsContext returns the list of my notifications with context
+func (c *Client) GetNotificationsContext(ctx context.Context, opts *GetNotificationsOptions, callOpts ...CallOption) ([]*Notification, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := "/api/v2/notifications"
+
+	u, err := c.AddOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []*Notification
+	if err := c.Do(ctx, req, &notifications); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// AllNotifications returns an iterator over my notifications
+func (c *Client) AllNotifications(opts *GetNotificationsOptions) iter.Seq2[*Notification, error] {
+	return c.AllNotificationsContext(context.Background(), opts)
+}
+
+// AllNotificationsContext returns an iterator over my notifications with context.
+// It follows the cursor of MinID or MaxID by Order, and yields each
+// notification once even if pages overlap. Count is the size of a page and
+// Order is descending by default. Iteration stops at the first error.
+func (c *Client) AllNotificationsContext(ctx context.Context, opts *GetNotificationsOptions, callOpts ...CallOption) iter.Seq2[*Notification, error] {
+	o := GetNotificationsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Count == nil {
+		o.Count = Int(maxNotificationCount)
+	}
+
+	return cursorSeq(*o.Count, o.Order == OrderAsc, o.MinID, o.MaxID,
+		func(n *Notification) *int { return n.ID },
+		func(minID, maxID *int) ([]*Notification, error) {
+			page := o
+			page.MinID, page.MaxID = minID, maxID
+			return c.GetNotificationsContext(ctx, &page, callOpts...)
+		})
+}
+
+// GetNotificationsCount returns the count of my notifications
+func (c *Client) GetNotificationsCount(opts *GetNotificationsCountOptions) (int, error) {
+	return c.GetNotificationsCountContext(context.Background(), opts)
+}
+
+// GetNotificationsCountContext returns the count of my notifications with context
+func (c *Client) GetNotificationsCountContext(ctx context.Context, opts *GetNotificationsCountOptions, callOpts ...CallOption) (int, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := "/api/v2/notifications/count"
+
+	u, err := c.AddOptions(u, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	r := new(p)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return 0, err
+	}
+	return r.Count, nil
+}
+
+// ResetUnreadNotificationCount resets the count of my unread notifications and
+// returns the count before the reset
+func (c *Client) ResetUnreadNotificationCount() (int, error) {
+	return c.ResetUnreadNotificationCountContext(context.Background())
+}
+
+// ResetUnreadNotificationCountContext resets the count of my unread notifications with context
+func (c *Client) ResetUnreadNotificationCountContext(ctx context.Context, callOpts ...CallOption) (int, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := "/api/v2/notifications/markAsRead"
+
+	req, err := c.NewRequest("POST", u, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	r := new(p)
+	if err := c.Do(ctx, req, &r); err != nil {
+		return 0, err
+	}
+	return r.Count, nil
+}
+
+// MarkAsReadNotification marks a notification as read
+func (c *Client) MarkAsReadNotification(notificationID int) error {
+	return c.MarkAsReadNotificationContext(context.Background(), notificationID)
+}
+
+// MarkAsReadNotificationContext marks a notification as read with context
+func (c *Client) MarkAsReadNotificationContext(ctx context.Context, notificationID int, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/notifications/%v/markAsRead", notificationID)
+
+	req, err := c.NewRequest("POST", u, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetNotificationsOptions specifies parameters to the GetNotifications method.
+type GetNotificationsOptions struct {
+	MinID    *int  `url:"minId,omitempty"`
+	MaxID    *int  `url:"maxId,omitempty"`
+	Count    *int  `url:"count,omitempty"`
+	Order    Order `url:"order,omitempty"`
+	SenderID *int  `url:"senderId,omitempty"`
+}
+
+// GetNotificationsCountOptions specifies parameters to the GetNotificationsCount method.
+type GetNotificationsCountOptions struct {
+	AlreadyRead         *bool `url:"alreadyRead,omitempty"`
+	ResourceAlreadyRead *bool `url:"resourceAlreadyRead,omitempty"`
+}
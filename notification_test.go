@@ -0,0 +1,145 @@
+package backlog
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNotifications(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "10", r.URL.Query().Get("maxId"))
+		assert.Equal(t, "desc", r.URL.Query().Get("order"))
+		if _, err := fmt.Fprintf(w, `[{
+			"id": 9,
+			"alreadyRead": false,
+			"reason": 2,
+			"resourceAlreadyRead": false,
+			"issue": {"id": 1, "issueKey": "BLG-1"},
+			"sender": {"id": 2, "name": "eguchi"},
+			"created": "2006-01-02T15:04:05Z"
+		}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	notifications, err := client.GetNotifications(&GetNotificationsOptions{MaxID: Int(10), Order: OrderDesc})
+	assert.NoError(t, err)
+	assert.Len(t, notifications, 1)
+	n := notifications[0]
+	assert.Equal(t, NotificationReasonCommented, n.ReasonType())
+	assert.Equal(t, "commented", n.ReasonType().String())
+	assert.Equal(t, "BLG-1", *n.Issue.IssueKey)
+	assert.Equal(t, "eguchi", *n.Sender.Name)
+	assert.Equal(t, referenceTime, n.Created.Time)
+}
+
+func TestAllNotifications(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// 5 notifications in pages of 2, where maxId is inclusive
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("count"))
+		maxID := 5
+		if s := r.URL.Query().Get("maxId"); s != "" {
+			maxID, _ = strconv.Atoi(s)
+		}
+		fmt.Fprint(w, "[")
+		for id := maxID; id > 0 && id > maxID-2; id-- {
+			if id != maxID {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"id": %d, "reason": 1}`, id)
+		}
+		fmt.Fprint(w, "]")
+	})
+
+	var ids []int
+	for n, err := range client.AllNotifications(&GetNotificationsOptions{Count: Int(2)}) {
+		assert.NoError(t, err)
+		ids = append(ids, *n.ID)
+	}
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, ids)
+
+	ids = nil
+	for n, err := range client.AllNotifications(&GetNotificationsOptions{Count: Int(2)}) {
+		assert.NoError(t, err)
+		ids = append(ids, *n.ID)
+		if len(ids) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{5, 4, 3}, ids)
+}
+
+func TestAllNotificationsFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	for n, err := range client.AllNotifications(nil) {
+		assert.Nil(t, n)
+		assert.Error(t, err)
+	}
+}
+
+func TestGetNotificationsCount(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/count", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "false", r.URL.Query().Get("alreadyRead"))
+		if _, err := fmt.Fprint(w, `{"count": 138}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	count, err := client.GetNotificationsCount(&GetNotificationsCountOptions{AlreadyRead: Bool(false)})
+	assert.NoError(t, err)
+	assert.Equal(t, 138, count)
+}
+
+func TestResetUnreadNotificationCount(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/markAsRead", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if _, err := fmt.Fprint(w, `{"count": 42}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	count, err := client.ResetUnreadNotificationCount()
+	assert.NoError(t, err)
+	assert.Equal(t, 42, count)
+}
+
+func TestMarkAsReadNotification(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/1/markAsRead", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assert.NoError(t, client.MarkAsReadNotification(1))
+}
+
+func TestNotificationReasonString(t *testing.T) {
+	assert.Equal(t, "pull request updated", NotificationReasonPullRequestUpdated.String())
+	assert.Equal(t, "NotificationReason(99)", NotificationReason(99).String())
+	assert.Equal(t, NotificationReason(0), (*Notification)(nil).ReasonType())
+}
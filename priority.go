@@ -14,7 +14,8 @@ func (c *Client) GetPriorities() ([]*Priority, error) {
 }
 
 // GetPrioritiesContext returns the list of priorities with context
-func (c *Client) GetPrioritiesContext(ctx context.Context) ([]*Priority, error) {
+func (c *Client) GetPrioritiesContext(ctx context.Context, callOpts ...CallOption) ([]*Priority, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/priorities"
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -51,7 +51,8 @@ func (c *Client) GetMyRecentlyViewedProjects(opts *GetMyRecentlyViewedProjectsOp
 }
 
 // GetMyRecentlyViewedProjectsContext returns the list of projects I recently viewed with context
-func (c *Client) GetMyRecentlyViewedProjectsContext(ctx context.Context, opts *GetMyRecentlyViewedProjectsOptions) ([]*RecentlyViewedProject, error) {
+func (c *Client) GetMyRecentlyViewedProjectsContext(ctx context.Context, opts *GetMyRecentlyViewedProjectsOptions, callOpts ...CallOption) ([]*RecentlyViewedProject, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u, err := c.AddOptions("/api/v2/users/myself/recentlyViewedProjects", opts)
 	if err != nil {
 		return nil, err
@@ -75,7 +76,8 @@ func (c *Client) GetProjects(opts *GetProjectsOptions) ([]*Project, error) {
 }
 
 // GetProjectsContext returns the list of projects
-func (c *Client) GetProjectsContext(ctx context.Context, opts *GetProjectsOptions) ([]*Project, error) {
+func (c *Client) GetProjectsContext(ctx context.Context, opts *GetProjectsOptions, callOpts ...CallOption) ([]*Project, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u, err := c.AddOptions("/api/v2/projects", opts)
 	if err != nil {
 		return nil, err
@@ -99,7 +101,8 @@ func (c *Client) GetProject(projectIDOrKey interface{}) (*Project, error) {
 }
 
 // GetProjectContext returns a project with context
-func (c *Client) GetProjectContext(ctx context.Context, projectIDOrKey interface{}) (*Project, error) {
+func (c *Client) GetProjectContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) (*Project, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -120,7 +123,8 @@ func (c *Client) GetStatuses(projectIDOrKey interface{}) ([]*Status, error) {
 }
 
 // GetStatusesContext returns the statuses of a project with context
-func (c *Client) GetStatusesContext(ctx context.Context, projectIDOrKey interface{}) ([]*Status, error) {
+func (c *Client) GetStatusesContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) ([]*Status, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/statuses", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -141,7 +145,8 @@ func (c *Client) CreateProject(input *CreateProjectInput) (*Project, error) {
 }
 
 // CreateProjectContext creates a project with Context
-func (c *Client) CreateProjectContext(ctx context.Context, input *CreateProjectInput) (*Project, error) {
+func (c *Client) CreateProjectContext(ctx context.Context, input *CreateProjectInput, callOpts ...CallOption) (*Project, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/projects"
 
 	req, err := c.NewRequest("POST", u, input)
@@ -162,7 +167,8 @@ func (c *Client) UpdateProject(id int, input *UpdateProjectInput) (*Project, err
 }
 
 // UpdateProjectContext updates a project with Context
-func (c *Client) UpdateProjectContext(ctx context.Context, id int, input *UpdateProjectInput) (*Project, error) {
+func (c *Client) UpdateProjectContext(ctx context.Context, id int, input *UpdateProjectInput, callOpts ...CallOption) (*Project, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v", id)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -183,7 +189,8 @@ func (c *Client) DeleteProject(projectIDOrKey interface{}) (*Project, error) {
 }
 
 // DeleteProjectContext deletes a project with Context
-func (c *Client) DeleteProjectContext(ctx context.Context, projectIDOrKey interface{}) (*Project, error) {
+func (c *Client) DeleteProjectContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) (*Project, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v", projectIDOrKey)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -204,7 +211,8 @@ func (c *Client) GetProjectIcon(projectIDOrKey interface{}, writer io.Writer) er
 }
 
 // GetProjectIconContext downloads project icon with context
-func (c *Client) GetProjectIconContext(ctx context.Context, projectIDOrKey interface{}, writer io.Writer) error {
+func (c *Client) GetProjectIconContext(ctx context.Context, projectIDOrKey interface{}, writer io.Writer, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/image", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -224,7 +232,8 @@ func (c *Client) AddProjectUser(projectIDOrKey interface{}, input *AddProjectUse
 }
 
 // AddProjectUserContext adds a user to a project with context
-func (c *Client) AddProjectUserContext(ctx context.Context, projectIDOrKey interface{}, input *AddProjectUserInput) (*User, error) {
+func (c *Client) AddProjectUserContext(ctx context.Context, projectIDOrKey interface{}, input *AddProjectUserInput, callOpts ...CallOption) (*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/users", projectIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -245,7 +254,8 @@ func (c *Client) GetProjectUsers(projectIDOrKey interface{}, opts *GetProjectUse
 }
 
 // GetProjectUsersContext returns the list of users in a project with context
-func (c *Client) GetProjectUsersContext(ctx context.Context, projectIDOrKey interface{}, opts *GetProjectUsersOptions) ([]*User, error) {
+func (c *Client) GetProjectUsersContext(ctx context.Context, projectIDOrKey interface{}, opts *GetProjectUsersOptions, callOpts ...CallOption) ([]*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/users", projectIDOrKey)
 
 	u, err := c.AddOptions(u, opts)
@@ -271,7 +281,8 @@ func (c *Client) DeleteProjectUser(projectIDOrKey interface{}, input *DeleteProj
 }
 
 // DeleteProjectUserContext deletes a user in a project with Context
-func (c *Client) DeleteProjectUserContext(ctx context.Context, projectIDOrKey interface{}, input *DeleteProjectUserInput) (*User, error) {
+func (c *Client) DeleteProjectUserContext(ctx context.Context, projectIDOrKey interface{}, input *DeleteProjectUserInput, callOpts ...CallOption) (*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/users", projectIDOrKey)
 
 	req, err := c.NewRequest("DELETE", u, input)
@@ -292,7 +303,8 @@ func (c *Client) AddProjectAdministrator(projectIDOrKey interface{}, input *AddP
 }
 
 // AddProjectAdministratorContext adds an administrator in a project with context
-func (c *Client) AddProjectAdministratorContext(ctx context.Context, projectIDOrKey interface{}, input *AddProjectAdministratorInput) (*User, error) {
+func (c *Client) AddProjectAdministratorContext(ctx context.Context, projectIDOrKey interface{}, input *AddProjectAdministratorInput, callOpts ...CallOption) (*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/administrators", projectIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -313,7 +325,8 @@ func (c *Client) GetProjectAdministrators(projectIDOrKey interface{}) ([]*User,
 }
 
 // GetProjectAdministratorsContext returns the list of administrators in a project with context
-func (c *Client) GetProjectAdministratorsContext(ctx context.Context, projectIDOrKey interface{}) ([]*User, error) {
+func (c *Client) GetProjectAdministratorsContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) ([]*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/administrators", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -334,7 +347,8 @@ func (c *Client) DeleteProjectAdministrator(projectIDOrKey interface{}, input *D
 }
 
 // DeleteProjectAdministratorContext deletes a administrator in a project with Context
-func (c *Client) DeleteProjectAdministratorContext(ctx context.Context, projectIDOrKey interface{}, input *DeleteProjectAdministratorInput) (*User, error) {
+func (c *Client) DeleteProjectAdministratorContext(ctx context.Context, projectIDOrKey interface{}, input *DeleteProjectAdministratorInput, callOpts ...CallOption) (*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/administrators", projectIDOrKey)
 
 	req, err := c.NewRequest("DELETE", u, input)
@@ -355,7 +369,8 @@ func (c *Client) CreateStatus(projectIDOrKey interface{}, input *CreateStatusInp
 }
 
 // CreateStatusContext creates a status
-func (c *Client) CreateStatusContext(ctx context.Context, projectIDOrKey interface{}, input *CreateStatusInput) (*Status, error) {
+func (c *Client) CreateStatusContext(ctx context.Context, projectIDOrKey interface{}, input *CreateStatusInput, callOpts ...CallOption) (*Status, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/statuses", projectIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -376,7 +391,8 @@ func (c *Client) UpdateStatus(projectIDOrKey interface{}, statusID int, input *U
 }
 
 // UpdateStatusContext updates a status
-func (c *Client) UpdateStatusContext(ctx context.Context, projectIDOrKey interface{}, statusID int, input *UpdateStatusInput) (*Status, error) {
+func (c *Client) UpdateStatusContext(ctx context.Context, projectIDOrKey interface{}, statusID int, input *UpdateStatusInput, callOpts ...CallOption) (*Status, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/statuses/%v", projectIDOrKey, statusID)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -397,7 +413,8 @@ func (c *Client) DeleteStatus(projectIDOrKey interface{}, statusID int, input *D
 }
 
 // DeleteStatusContext deletes a status
-func (c *Client) DeleteStatusContext(ctx context.Context, projectIDOrKey interface{}, statusID int, input *DeleteStatusInput) (*Status, error) {
+func (c *Client) DeleteStatusContext(ctx context.Context, projectIDOrKey interface{}, statusID int, input *DeleteStatusInput, callOpts ...CallOption) (*Status, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/statuses/%v", projectIDOrKey, statusID)
 
 	req, err := c.NewRequest("DELETE", u, input)
@@ -418,7 +435,8 @@ func (c *Client) SortStatuses(projectIDOrKey interface{}, input *SortStatusesInp
 }
 
 // SortStatusesContext sorts the list of statuses with context
-func (c *Client) SortStatusesContext(ctx context.Context, projectIDOrKey interface{}, input *SortStatusesInput) ([]*Status, error) {
+func (c *Client) SortStatusesContext(ctx context.Context, projectIDOrKey interface{}, input *SortStatusesInput, callOpts ...CallOption) ([]*Status, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/statuses/updateDisplayOrder", projectIDOrKey)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -439,7 +457,8 @@ func (c *Client) GetProjectDiskUsage(projectIDOrKey interface{}) (*ProjectDiskUs
 }
 
 // GetProjectDiskUsageContext returns the list of administrators in a project with context
-func (c *Client) GetProjectDiskUsageContext(ctx context.Context, projectIDOrKey interface{}) (*ProjectDiskUsage, error) {
+func (c *Client) GetProjectDiskUsageContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) (*ProjectDiskUsage, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/diskUsage", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
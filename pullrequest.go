@@ -3,6 +3,11 @@ package backlog
 import (
 	"context"
 	"fmt"
+	"io"
+	"iter"
+	"os"
+
+	"github.com/pkg/errors"
 )
 
 // PullRequest : pull request
@@ -73,6 +78,17 @@ type UpdatePullRequestOptions struct {
 	Comment        *string `json:"comment,omitempty"`
 }
 
+// AddPullRequestCommentOptions : options for AddPullRequestComment
+type AddPullRequestCommentOptions struct {
+	Content        *string `json:"content,omitempty"`
+	NotifiedUserID []int   `json:"notifiedUserId,omitempty"`
+}
+
+// UpdatePullRequestCommentOptions : options for UpdatePullRequestComment
+type UpdatePullRequestCommentOptions struct {
+	Content *string `json:"content,omitempty"`
+}
+
 // ResponsePullRequests : response for pull requests
 type ResponsePullRequests []*PullRequest
 
@@ -90,7 +106,8 @@ func (c *Client) GetPullRequests(projectIDOrKey interface{}, repoIDOrName interf
 }
 
 // GetPullRequestsContext returns pull requests
-func (c *Client) GetPullRequestsContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, options *GetPullRequestsOptions) (*ResponsePullRequests, error) {
+func (c *Client) GetPullRequestsContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, options *GetPullRequestsOptions, callOpts ...CallOption) (*ResponsePullRequests, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests", projectIDOrKey, repoIDOrName)
 
 	if options != nil {
@@ -120,7 +137,8 @@ func (c *Client) GetPullRequestsCount(projectIDOrKey interface{}, repoIDOrName i
 }
 
 // GetPullRequestsCountContext returns pull requests count
-func (c *Client) GetPullRequestsCountContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, options *GetPullRequestsOptions) (*ResponsePullRequestCount, error) {
+func (c *Client) GetPullRequestsCountContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, options *GetPullRequestsOptions, callOpts ...CallOption) (*ResponsePullRequestCount, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/count", projectIDOrKey, repoIDOrName)
 
 	if options != nil {
@@ -150,7 +168,8 @@ func (c *Client) GetPullRequest(projectIDOrKey interface{}, repoIDOrName interfa
 }
 
 // GetPullRequestContext returns pull request
-func (c *Client) GetPullRequestContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int) (*PullRequest, error) {
+func (c *Client) GetPullRequestContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int, callOpts ...CallOption) (*PullRequest, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/%d", projectIDOrKey, repoIDOrName, number)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -172,7 +191,8 @@ func (c *Client) CreatePullRequest(projectIDOrKey interface{}, repoIDOrName inte
 }
 
 // CreatePullRequestContext creates pull request
-func (c *Client) CreatePullRequestContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, options *CreatePullRequestOptions) (*PullRequest, error) {
+func (c *Client) CreatePullRequestContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, options *CreatePullRequestOptions, callOpts ...CallOption) (*PullRequest, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests", projectIDOrKey, repoIDOrName)
 
 	req, err := c.NewRequest("POST", u, options)
@@ -194,7 +214,8 @@ func (c *Client) UpdatePullRequest(projectIDOrKey interface{}, repoIDOrName inte
 }
 
 // UpdatePullRequestContext updates pull request
-func (c *Client) UpdatePullRequestContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int, options *UpdatePullRequestOptions) (*PullRequest, error) {
+func (c *Client) UpdatePullRequestContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int, options *UpdatePullRequestOptions, callOpts ...CallOption) (*PullRequest, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/%d", projectIDOrKey, repoIDOrName, number)
 
 	req, err := c.NewRequest("PATCH", u, options)
@@ -212,10 +233,10 @@ func (c *Client) UpdatePullRequestContext(ctx context.Context, projectIDOrKey in
 
 // GetPullRequestCommentsOptions : options for GetPullRequestComments
 type GetPullRequestCommentsOptions struct {
-	MinID *int    `json:"minId,omitempty"`
-	MaxID *int    `json:"maxId,omitempty"`
-	Count *int    `json:"count,omitempty"`
-	Order *string `json:"order,omitempty"`
+	MinID *int    `url:"minId,omitempty"`
+	MaxID *int    `url:"maxId,omitempty"`
+	Count *int    `url:"count,omitempty"`
+	Order *string `url:"order,omitempty"`
 }
 
 // GetPullRequestComments returns pull request comments
@@ -224,7 +245,8 @@ func (c *Client) GetPullRequestComments(projectIDOrKey interface{}, repoIDOrName
 }
 
 // GetPullRequestCommentsContext returns pull request comments
-func (c *Client) GetPullRequestCommentsContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int, options *GetPullRequestCommentsOptions) (*ResponsePullRequestComments, error) {
+func (c *Client) GetPullRequestCommentsContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int, options *GetPullRequestCommentsOptions, callOpts ...CallOption) (*ResponsePullRequestComments, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/%d/comments", projectIDOrKey, repoIDOrName, number)
 
 	if options != nil {
@@ -259,7 +281,8 @@ func (c *Client) GetPullRequestCommentsCount(projectIDOrKey interface{}, repoIDO
 }
 
 // GetPullRequestCommentsCountContext returns pull request comments count
-func (c *Client) GetPullRequestCommentsCountContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int) (*ResponsePullRequestCommentsCount, error) {
+func (c *Client) GetPullRequestCommentsCountContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int, callOpts ...CallOption) (*ResponsePullRequestCommentsCount, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/%d/comments/count", projectIDOrKey, repoIDOrName, number)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -274,3 +297,171 @@ func (c *Client) GetPullRequestCommentsCountContext(ctx context.Context, project
 
 	return responseCount, nil
 }
+
+// maxPullRequestCommentCount is the maximum count of pull request comments in a request
+const maxPullRequestCommentCount = 100
+
+// AllPullRequestComments returns an iterator over pull request comments
+func (c *Client) AllPullRequestComments(projectIDOrKey interface{}, repoIDOrName interface{}, number int, options *GetPullRequestCommentsOptions) iter.Seq2[*PullRequestComment, error] {
+	return c.AllPullRequestCommentsContext(context.Background(), projectIDOrKey, repoIDOrName, number, options)
+}
+
+// AllPullRequestCommentsContext returns an iterator over pull request comments with context.
+// It follows the cursor of MinID or MaxID by Order, which is descending by default.
+func (c *Client) AllPullRequestCommentsContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int, options *GetPullRequestCommentsOptions, callOpts ...CallOption) iter.Seq2[*PullRequestComment, error] {
+	o := GetPullRequestCommentsOptions{}
+	if options != nil {
+		o = *options
+	}
+	if o.Count == nil {
+		o.Count = Int(maxPullRequestCommentCount)
+	}
+
+	return cursorSeq(*o.Count, o.Order != nil && *o.Order == OrderAsc.String(), o.MinID, o.MaxID,
+		func(comment *PullRequestComment) *int { return comment.ID },
+		func(minID, maxID *int) ([]*PullRequestComment, error) {
+			page := o
+			page.MinID, page.MaxID = minID, maxID
+			comments, err := c.GetPullRequestCommentsContext(ctx, projectIDOrKey, repoIDOrName, number, &page, callOpts...)
+			if err != nil {
+				return nil, err
+			}
+			return *comments, nil
+		})
+}
+
+// AddPullRequestComment adds a comment to pull request
+func (c *Client) AddPullRequestComment(projectIDOrKey interface{}, repoIDOrName interface{}, number int, options *AddPullRequestCommentOptions) (*PullRequestComment, error) {
+	return c.AddPullRequestCommentContext(context.Background(), projectIDOrKey, repoIDOrName, number, options)
+}
+
+// AddPullRequestCommentContext adds a comment to pull request
+func (c *Client) AddPullRequestCommentContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int, options *AddPullRequestCommentOptions, callOpts ...CallOption) (*PullRequestComment, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/%d/comments", projectIDOrKey, repoIDOrName, number)
+
+	req, err := c.NewRequest("POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := new(PullRequestComment)
+	if err := c.Do(ctx, req, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// UpdatePullRequestComment updates a comment of pull request
+func (c *Client) UpdatePullRequestComment(projectIDOrKey interface{}, repoIDOrName interface{}, number, commentID int, options *UpdatePullRequestCommentOptions) (*PullRequestComment, error) {
+	return c.UpdatePullRequestCommentContext(context.Background(), projectIDOrKey, repoIDOrName, number, commentID, options)
+}
+
+// UpdatePullRequestCommentContext updates a comment of pull request
+func (c *Client) UpdatePullRequestCommentContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number, commentID int, options *UpdatePullRequestCommentOptions, callOpts ...CallOption) (*PullRequestComment, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/%d/comments/%d", projectIDOrKey, repoIDOrName, number, commentID)
+
+	req, err := c.NewRequest("PATCH", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := new(PullRequestComment)
+	if err := c.Do(ctx, req, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// GetPullRequestAttachments returns attachments of pull request
+func (c *Client) GetPullRequestAttachments(projectIDOrKey interface{}, repoIDOrName interface{}, number int) ([]*Attachment, error) {
+	return c.GetPullRequestAttachmentsContext(context.Background(), projectIDOrKey, repoIDOrName, number)
+}
+
+// GetPullRequestAttachmentsContext returns attachments of pull request
+func (c *Client) GetPullRequestAttachmentsContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number int, callOpts ...CallOption) ([]*Attachment, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/%d/attachments", projectIDOrKey, repoIDOrName, number)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := []*Attachment{}
+	if err := c.Do(ctx, req, &attachments); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// GetPullRequestAttachment downloads an attachment of pull request
+func (c *Client) GetPullRequestAttachment(projectIDOrKey interface{}, repoIDOrName interface{}, number, attachmentID int, writer io.Writer) error {
+	return c.GetPullRequestAttachmentContext(context.Background(), projectIDOrKey, repoIDOrName, number, attachmentID, writer)
+}
+
+// GetPullRequestAttachmentContext downloads an attachment of pull request
+func (c *Client) GetPullRequestAttachmentContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number, attachmentID int, writer io.Writer, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/%d/attachments/%d", projectIDOrKey, repoIDOrName, number, attachmentID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, writer)
+}
+
+// DeletePullRequestAttachment deletes an attachment of pull request
+func (c *Client) DeletePullRequestAttachment(projectIDOrKey interface{}, repoIDOrName interface{}, number, attachmentID int) (*Attachment, error) {
+	return c.DeletePullRequestAttachmentContext(context.Background(), projectIDOrKey, repoIDOrName, number, attachmentID)
+}
+
+// DeletePullRequestAttachmentContext deletes an attachment of pull request
+func (c *Client) DeletePullRequestAttachmentContext(ctx context.Context, projectIDOrKey interface{}, repoIDOrName interface{}, number, attachmentID int, callOpts ...CallOption) (*Attachment, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/projects/%v/git/repositories/%v/pullRequests/%d/attachments/%d", projectIDOrKey, repoIDOrName, number, attachmentID)
+
+	req, err := c.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := new(Attachment)
+	if err := c.Do(ctx, req, attachment); err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// UploadPullRequestAttachment uploads a file to attach to pull request by
+// AttachmentID of CreatePullRequestOptions. The file must not be larger than
+// PullRequestAttachmentLimitPerFile of the license.
+func (c *Client) UploadPullRequestAttachment(fpath string) (*FileUploadResponse, error) {
+	return c.UploadPullRequestAttachmentContext(context.Background(), fpath)
+}
+
+// UploadPullRequestAttachmentContext uploads a file to attach to pull request with context
+func (c *Client) UploadPullRequestAttachmentContext(ctx context.Context, fpath string, callOpts ...CallOption) (*FileUploadResponse, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	info, err := os.Stat(fpath)
+	if err != nil {
+		return nil, err
+	}
+
+	license, err := c.GetLicenceContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if limit := license.PullRequestAttachmentLimitPerFile; limit != nil && info.Size() > int64(*limit) {
+		return nil, errors.Errorf("%s is %d bytes, which exceeds the limit of pull request attachments %d bytes", fpath, info.Size(), *limit)
+	}
+
+	return c.UploadFileContext(ctx, fpath)
+}
@@ -1,17 +1,23 @@
 package backlog
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGetPullRequests(t *testing.T) {
@@ -398,3 +404,140 @@ func TestGetPullRequests_NewRequestError(t *testing.T) {
 
 	client.baseURL = originalBaseURL
 }
+
+func TestGetPullRequestCommentsOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/TEST/git/repositories/test-repo/pullRequests/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "1", q.Get("minId"))
+		assert.Equal(t, "10", q.Get("count"))
+		assert.Equal(t, "asc", q.Get("order"))
+		_, _ = fmt.Fprint(w, `[{"id": 2, "content": "LGTM"}]`)
+	})
+
+	comments, err := client.GetPullRequestComments("TEST", "test-repo", 1, &GetPullRequestCommentsOptions{
+		MinID: Int(1),
+		Count: Int(10),
+		Order: String("asc"),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, *comments, 1)
+	assert.Equal(t, "LGTM", *(*comments)[0].Content)
+}
+
+func TestAllPullRequestComments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// comments 1 to 5 in pages of 2, where minId is inclusive
+	mux.HandleFunc("/projects/TEST/git/repositories/test-repo/pullRequests/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		minID, _ := strconv.Atoi(r.URL.Query().Get("minId"))
+		minID = max(minID, 1)
+		var items []string
+		for id := minID; id <= 5 && id < minID+2; id++ {
+			items = append(items, fmt.Sprintf(`{"id": %d}`, id))
+		}
+		_, _ = fmt.Fprintf(w, "[%s]", strings.Join(items, ","))
+	})
+
+	var ids []int
+	for comment, err := range client.AllPullRequestComments("TEST", "test-repo", 1, &GetPullRequestCommentsOptions{Count: Int(2), Order: String("asc")}) {
+		assert.NoError(t, err)
+		ids = append(ids, *comment.ID)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+}
+
+func TestAddPullRequestComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/TEST/git/repositories/test-repo/pullRequests/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"content": "tests passed", "notifiedUserId": [1]}`, string(b))
+		_, _ = fmt.Fprint(w, `{"id": 1, "content": "tests passed"}`)
+	})
+
+	comment, err := client.AddPullRequestComment("TEST", "test-repo", 1, &AddPullRequestCommentOptions{
+		Content:        String("tests passed"),
+		NotifiedUserID: []int{1},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &PullRequestComment{ID: Int(1), Content: String("tests passed")}, comment)
+}
+
+func TestUpdatePullRequestComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/TEST/git/repositories/test-repo/pullRequests/1/comments/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		_, _ = fmt.Fprint(w, `{"id": 2, "content": "tests failed"}`)
+	})
+
+	comment, err := client.UpdatePullRequestComment("TEST", "test-repo", 1, 2, &UpdatePullRequestCommentOptions{
+		Content: String("tests failed"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "tests failed", *comment.Content)
+}
+
+func TestPullRequestAttachments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/TEST/git/repositories/test-repo/pullRequests/1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `[{"id": 1, "name": "result.txt", "size": 5}]`)
+	})
+	mux.HandleFunc("/projects/TEST/git/repositories/test-repo/pullRequests/1/attachments/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			_, _ = fmt.Fprint(w, "hello")
+		case "DELETE":
+			_, _ = fmt.Fprint(w, `{"id": 1, "name": "result.txt", "size": 5}`)
+		}
+	})
+
+	attachments, err := client.GetPullRequestAttachments("TEST", "test-repo", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Attachment{{ID: Int(1), Name: String("result.txt"), Size: Int(5)}}, attachments)
+
+	var buf bytes.Buffer
+	assert.NoError(t, client.GetPullRequestAttachment("TEST", "test-repo", 1, 1, &buf))
+	assert.Equal(t, "hello", buf.String())
+
+	attachment, err := client.DeletePullRequestAttachment("TEST", "test-repo", 1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "result.txt", *attachment.Name)
+}
+
+func TestUploadPullRequestAttachment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/space/licence", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"pullRequestAttachmentLimitPerFile": 5}`)
+	})
+	mux.HandleFunc("/space/attachment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		_, _ = fmt.Fprint(w, `{"id": 1, "name": "ok.txt", "size": 5}`)
+	})
+
+	dir := t.TempDir()
+	ok := filepath.Join(dir, "ok.txt")
+	large := filepath.Join(dir, "large.txt")
+	assert.NoError(t, os.WriteFile(ok, []byte("hello"), 0o600))
+	assert.NoError(t, os.WriteFile(large, []byte("hello!"), 0o600))
+
+	file, err := client.UploadPullRequestAttachment(ok)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *file.ID)
+
+	_, err = client.UploadPullRequestAttachment(large)
+	assert.ErrorContains(t, err, "exceeds the limit")
+}
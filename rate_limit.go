@@ -39,7 +39,8 @@ func (c *Client) GetRateLimit() (*RateLimit, error) {
 }
 
 // GetRateLimitContext returns the rate limit
-func (c *Client) GetRateLimitContext(ctx context.Context) (*RateLimit, error) {
+func (c *Client) GetRateLimitContext(ctx context.Context, callOpts ...CallOption) (*RateLimit, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/rateLimit"
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -14,7 +14,8 @@ func (c *Client) GetResolutions() ([]*Resolution, error) {
 }
 
 // GetResolutionsContext returns the list of resolutions with context
-func (c *Client) GetResolutionsContext(ctx context.Context) ([]*Resolution, error) {
+func (c *Client) GetResolutionsContext(ctx context.Context, callOpts ...CallOption) ([]*Resolution, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/resolutions"
 
 	req, err := c.NewRequest("GET", u, nil)
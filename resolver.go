@@ -0,0 +1,646 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NameNotFoundError is returned by Resolver when no resource matches a name
+type NameNotFoundError struct {
+	Kind string
+	Name string
+}
+
+func (e *NameNotFoundError) Error() string {
+	return fmt.Sprintf("%s %q is not found", e.Kind, e.Name)
+}
+
+// AmbiguousNameError is returned by Resolver when several resources match a name
+type AmbiguousNameError struct {
+	Kind       string
+	Name       string
+	Candidates []string
+}
+
+func (e *AmbiguousNameError) Error() string {
+	return fmt.Sprintf("%s %q is ambiguous: %s", e.Kind, e.Name, strings.Join(e.Candidates, ", "))
+}
+
+// Resolver looks up the resources of a project by name.
+// Resources are fetched at the first lookup and cached until Reset is called.
+//
+// Names are matched exactly first, then case-insensitively with full-width
+// alphanumerics and half-width katakana folded, so "ｂｕｇ" matches "Bug"
+// and "ｼｮﾘﾁｭｳ" matches "ショリチュウ".
+type Resolver struct {
+	client         *Client
+	projectIDOrKey interface{}
+
+	mu           sync.Mutex
+	project      *Project
+	statuses     []*Status
+	issueTypes   []*IssueType
+	categories   []*Category
+	versions     []*Version
+	priorities   []*Priority
+	resolutions  []*Resolution
+	customFields []*CustomField
+	users        []*User
+}
+
+// NewResolver returns a Resolver bound to a project
+func (c *Client) NewResolver(projectIDOrKey interface{}) *Resolver {
+	return &Resolver{
+		client:         c,
+		projectIDOrKey: projectIDOrKey,
+	}
+}
+
+// Reset drops the cached resources
+func (r *Resolver) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.project = nil
+	r.statuses = nil
+	r.issueTypes = nil
+	r.categories = nil
+	r.versions = nil
+	r.priorities = nil
+	r.resolutions = nil
+	r.customFields = nil
+	r.users = nil
+}
+
+// halfWidthKatakana maps U+FF61 - U+FF9F to the full-width forms.
+var halfWidthKatakana = []rune("。「」、・ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン゛゜")
+
+// normalizeName folds case, full-width alphanumerics, half-width katakana and spaces.
+func normalizeName(s string) string {
+	rs := []rune(s)
+	out := make([]rune, 0, len(rs))
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		switch {
+		case r == '　':
+			r = ' '
+		case r >= '！' && r <= '～':
+			r -= 0xfee0
+		case r >= '｡' && r <= 'ﾟ':
+			r = halfWidthKatakana[r-'｡']
+			if i+1 < len(rs) {
+				switch rs[i+1] {
+				case 'ﾞ':
+					if v, ok := voicedKatakana(r); ok {
+						r = v
+						i++
+					}
+				case 'ﾟ':
+					if v, ok := semiVoicedKatakana(r); ok {
+						r = v
+						i++
+					}
+				}
+			}
+		}
+		out = append(out, r)
+	}
+	return strings.Join(strings.Fields(strings.ToLower(string(out))), " ")
+}
+
+func voicedKatakana(r rune) (rune, bool) {
+	switch {
+	case r == 'ウ':
+		return 'ヴ', true
+	case strings.ContainsRune("カキクケコサシスセソタチツテトハヒフヘホ", r):
+		return r + 1, true
+	}
+	return r, false
+}
+
+func semiVoicedKatakana(r rune) (rune, bool) {
+	if strings.ContainsRune("ハヒフヘホ", r) {
+		return r + 2, true
+	}
+	return r, false
+}
+
+// resolveByName returns the only item which has the name in its keys
+func resolveByName[T any](kind, name string, items []T, keys func(T) []string) (T, error) {
+	var zero T
+
+	find := func(eq func(string) bool) []T {
+		var found []T
+		for _, item := range items {
+			for _, k := range keys(item) {
+				if k != "" && eq(k) {
+					found = append(found, item)
+					break
+				}
+			}
+		}
+		return found
+	}
+
+	found := find(func(k string) bool { return k == name })
+	if len(found) == 0 {
+		n := normalizeName(name)
+		found = find(func(k string) bool { return normalizeName(k) == n })
+	}
+
+	switch len(found) {
+	case 0:
+		return zero, &NameNotFoundError{Kind: kind, Name: name}
+	case 1:
+		return found[0], nil
+	default:
+		candidates := make([]string, 0, len(found))
+		for _, item := range found {
+			candidates = append(candidates, strings.Join(keys(item), "/"))
+		}
+		return zero, &AmbiguousNameError{Kind: kind, Name: name, Candidates: candidates}
+	}
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// Project returns the project the resolver is bound to
+func (r *Resolver) Project() (*Project, error) {
+	return r.ProjectContext(context.Background())
+}
+
+// ProjectContext returns the project the resolver is bound to with context
+func (r *Resolver) ProjectContext(ctx context.Context) (*Project, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.project == nil {
+		project, err := r.client.GetProjectContext(ctx, r.projectIDOrKey)
+		if err != nil {
+			return nil, err
+		}
+		r.project = project
+	}
+	return r.project, nil
+}
+
+// Status returns a status of the project by name
+func (r *Resolver) Status(name string) (*Status, error) {
+	return r.StatusContext(context.Background(), name)
+}
+
+// StatusContext returns a status of the project by name with context
+func (r *Resolver) StatusContext(ctx context.Context, name string) (*Status, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.statuses == nil {
+		statuses, err := r.client.GetStatusesContext(ctx, r.projectIDOrKey)
+		if err != nil {
+			return nil, err
+		}
+		r.statuses = statuses
+	}
+	return resolveByName("status", name, r.statuses, func(s *Status) []string {
+		return []string{derefString(s.Name)}
+	})
+}
+
+// IssueType returns an issue type of the project by name
+func (r *Resolver) IssueType(name string) (*IssueType, error) {
+	return r.IssueTypeContext(context.Background(), name)
+}
+
+// IssueTypeContext returns an issue type of the project by name with context
+func (r *Resolver) IssueTypeContext(ctx context.Context, name string) (*IssueType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.issueTypes == nil {
+		issueTypes, err := r.client.GetIssueTypesContext(ctx, r.projectIDOrKey)
+		if err != nil {
+			return nil, err
+		}
+		r.issueTypes = issueTypes
+	}
+	return resolveByName("issue type", name, r.issueTypes, func(t *IssueType) []string {
+		return []string{derefString(t.Name)}
+	})
+}
+
+// Category returns a category of the project by name
+func (r *Resolver) Category(name string) (*Category, error) {
+	return r.CategoryContext(context.Background(), name)
+}
+
+// CategoryContext returns a category of the project by name with context
+func (r *Resolver) CategoryContext(ctx context.Context, name string) (*Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.categories == nil {
+		categories, err := r.client.GetCategoriesContext(ctx, r.projectIDOrKey)
+		if err != nil {
+			return nil, err
+		}
+		r.categories = categories
+	}
+	return resolveByName("category", name, r.categories, func(c *Category) []string {
+		return []string{derefString(c.Name)}
+	})
+}
+
+// Version returns a version of the project by name.
+// Versions and milestones share the same list in Backlog.
+func (r *Resolver) Version(name string) (*Version, error) {
+	return r.VersionContext(context.Background(), name)
+}
+
+// VersionContext returns a version of the project by name with context
+func (r *Resolver) VersionContext(ctx context.Context, name string) (*Version, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.versions == nil {
+		versions, err := r.client.GetVersionsContext(ctx, r.projectIDOrKey)
+		if err != nil {
+			return nil, err
+		}
+		r.versions = versions
+	}
+	return resolveByName("version", name, r.versions, func(v *Version) []string {
+		return []string{derefString(v.Name)}
+	})
+}
+
+// Milestone returns a milestone of the project by name
+func (r *Resolver) Milestone(name string) (*Version, error) {
+	return r.MilestoneContext(context.Background(), name)
+}
+
+// MilestoneContext returns a milestone of the project by name with context
+func (r *Resolver) MilestoneContext(ctx context.Context, name string) (*Version, error) {
+	return r.VersionContext(ctx, name)
+}
+
+// Priority returns a priority by name
+func (r *Resolver) Priority(name string) (*Priority, error) {
+	return r.PriorityContext(context.Background(), name)
+}
+
+// PriorityContext returns a priority by name with context
+func (r *Resolver) PriorityContext(ctx context.Context, name string) (*Priority, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.priorities == nil {
+		priorities, err := r.client.GetPrioritiesContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		r.priorities = priorities
+	}
+	return resolveByName("priority", name, r.priorities, func(p *Priority) []string {
+		return []string{derefString(p.Name)}
+	})
+}
+
+// Resolution returns a resolution by name
+func (r *Resolver) Resolution(name string) (*Resolution, error) {
+	return r.ResolutionContext(context.Background(), name)
+}
+
+// ResolutionContext returns a resolution by name with context
+func (r *Resolver) ResolutionContext(ctx context.Context, name string) (*Resolution, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.resolutions == nil {
+		resolutions, err := r.client.GetResolutionsContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		r.resolutions = resolutions
+	}
+	return resolveByName("resolution", name, r.resolutions, func(res *Resolution) []string {
+		return []string{derefString(res.Name)}
+	})
+}
+
+// CustomField returns a custom field of the project by name
+func (r *Resolver) CustomField(name string) (*CustomField, error) {
+	return r.CustomFieldContext(context.Background(), name)
+}
+
+// CustomFieldContext returns a custom field of the project by name with context
+func (r *Resolver) CustomFieldContext(ctx context.Context, name string) (*CustomField, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.customFields == nil {
+		customFields, err := r.client.GetCustomFieldsContext(ctx, r.projectIDOrKey)
+		if err != nil {
+			return nil, err
+		}
+		r.customFields = customFields
+	}
+	return resolveByName("custom field", name, r.customFields, func(f *CustomField) []string {
+		return []string{derefString(f.Name)}
+	})
+}
+
+// CustomFieldItem returns an item of a list type custom field by names
+func (r *Resolver) CustomFieldItem(fieldName, itemName string) (*Item, error) {
+	return r.CustomFieldItemContext(context.Background(), fieldName, itemName)
+}
+
+// CustomFieldItemContext returns an item of a list type custom field by names with context
+func (r *Resolver) CustomFieldItemContext(ctx context.Context, fieldName, itemName string) (*Item, error) {
+	field, err := r.CustomFieldContext(ctx, fieldName)
+	if err != nil {
+		return nil, err
+	}
+	return resolveByName("custom field item", itemName, field.Items, func(i *Item) []string {
+		return []string{derefString(i.Name)}
+	})
+}
+
+// User returns a user of the project by userId, name or mail address
+func (r *Resolver) User(name string) (*User, error) {
+	return r.UserContext(context.Background(), name)
+}
+
+// UserContext returns a user of the project by userId, name or mail address with context
+func (r *Resolver) UserContext(ctx context.Context, name string) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.users == nil {
+		users, err := r.client.GetProjectUsersContext(ctx, r.projectIDOrKey, nil)
+		if err != nil {
+			return nil, err
+		}
+		r.users = users
+	}
+	return resolveByName("user", name, r.users, func(u *User) []string {
+		return []string{derefString(u.UserID), derefString(u.Name), derefString(u.MailAddress)}
+	})
+}
+
+// IssueSpec describes an issue with names instead of IDs.
+// Nil and empty fields are left unchanged.
+//
+// The values of CustomFields are keyed by custom field name. For list type
+// custom fields, a value is an item name or a slice of item names.
+type IssueSpec struct {
+	Summary        *string
+	Description    *string
+	ParentIssueID  *int
+	StartDate      *string
+	DueDate        *string
+	EstimatedHours *float64
+	ActualHours    *float64
+	IssueType      *string
+	Status         *string
+	Resolution     *string
+	Priority       *string
+	Assignee       *string
+	Categories     []string
+	Versions       []string
+	Milestones     []string
+	NotifiedUsers  []string
+	CustomFields   map[string]interface{}
+	Comment        *string
+}
+
+// resolvedIssueSpec holds the IDs resolved from an IssueSpec
+type resolvedIssueSpec struct {
+	issueTypeID     *int
+	statusID        *int
+	resolutionID    *int
+	priorityID      *int
+	assigneeID      *int
+	categoryIDs     []int
+	versionIDs      []int
+	milestoneIDs    []int
+	notifiedUserIDs []int
+	customFields    []*IssueCustomField
+}
+
+func (r *Resolver) resolveIssueSpec(ctx context.Context, spec *IssueSpec) (*resolvedIssueSpec, error) {
+	res := &resolvedIssueSpec{}
+
+	if spec.IssueType != nil {
+		t, err := r.IssueTypeContext(ctx, *spec.IssueType)
+		if err != nil {
+			return nil, err
+		}
+		res.issueTypeID = t.ID
+	}
+	if spec.Status != nil {
+		s, err := r.StatusContext(ctx, *spec.Status)
+		if err != nil {
+			return nil, err
+		}
+		res.statusID = s.ID
+	}
+	if spec.Resolution != nil {
+		s, err := r.ResolutionContext(ctx, *spec.Resolution)
+		if err != nil {
+			return nil, err
+		}
+		res.resolutionID = s.ID
+	}
+	if spec.Priority != nil {
+		p, err := r.PriorityContext(ctx, *spec.Priority)
+		if err != nil {
+			return nil, err
+		}
+		res.priorityID = p.ID
+	}
+	if spec.Assignee != nil {
+		u, err := r.UserContext(ctx, *spec.Assignee)
+		if err != nil {
+			return nil, err
+		}
+		res.assigneeID = u.ID
+	}
+	for _, name := range spec.Categories {
+		c, err := r.CategoryContext(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		res.categoryIDs = append(res.categoryIDs, *c.ID)
+	}
+	for _, name := range spec.Versions {
+		v, err := r.VersionContext(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		res.versionIDs = append(res.versionIDs, *v.ID)
+	}
+	for _, name := range spec.Milestones {
+		v, err := r.MilestoneContext(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		res.milestoneIDs = append(res.milestoneIDs, *v.ID)
+	}
+	for _, name := range spec.NotifiedUsers {
+		u, err := r.UserContext(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		res.notifiedUserIDs = append(res.notifiedUserIDs, *u.ID)
+	}
+	for name, value := range spec.CustomFields {
+		cf, err := r.resolveCustomFieldValue(ctx, name, value)
+		if err != nil {
+			return nil, err
+		}
+		res.customFields = append(res.customFields, cf)
+	}
+	sort.Slice(res.customFields, func(i, j int) bool {
+		return *res.customFields[i].ID < *res.customFields[j].ID
+	})
+	return res, nil
+}
+
+// isListCustomField reports whether a custom field type takes items:
+// 5: single list, 6: multiple list, 7: checkbox, 8: radio
+func isListCustomField(typeID *int) bool {
+	return typeID != nil && *typeID >= 5 && *typeID <= 8
+}
+
+func (r *Resolver) resolveCustomFieldValue(ctx context.Context, name string, value interface{}) (*IssueCustomField, error) {
+	field, err := r.CustomFieldContext(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	cf := &IssueCustomField{
+		ID:          field.ID,
+		FieldTypeID: field.TypeID,
+		Name:        field.Name,
+		Value:       value,
+	}
+	if !isListCustomField(field.TypeID) {
+		return cf, nil
+	}
+
+	var names []string
+	switch v := value.(type) {
+	case string:
+		names = []string{v}
+	case []string:
+		names = v
+	default:
+		return nil, fmt.Errorf("custom field %q takes item names, but got %T", name, value)
+	}
+
+	items := []*Item{}
+	for _, n := range names {
+		item, err := resolveByName("custom field item", n, field.Items, func(i *Item) []string {
+			return []string{derefString(i.Name)}
+		})
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	cf.Value = items
+	return cf, nil
+}
+
+// CreateIssueInput converts an IssueSpec into CreateIssueInput
+func (r *Resolver) CreateIssueInput(spec *IssueSpec) (*CreateIssueInput, error) {
+	return r.CreateIssueInputContext(context.Background(), spec)
+}
+
+// CreateIssueInputContext converts an IssueSpec into CreateIssueInput with context
+func (r *Resolver) CreateIssueInputContext(ctx context.Context, spec *IssueSpec) (*CreateIssueInput, error) {
+	if spec.Status != nil || spec.Resolution != nil || spec.Comment != nil {
+		return nil, fmt.Errorf("status, resolution and comment can not be set on creating an issue")
+	}
+
+	project, err := r.ProjectContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.resolveIssueSpec(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateIssueInput{
+		ProjectID:       project.ID,
+		Summary:         spec.Summary,
+		ParentIssueID:   spec.ParentIssueID,
+		Description:     spec.Description,
+		StartDate:       spec.StartDate,
+		DueDate:         spec.DueDate,
+		EstimatedHours:  spec.EstimatedHours,
+		ActualHours:     spec.ActualHours,
+		IssueTypeID:     res.issueTypeID,
+		CategoryIDs:     res.categoryIDs,
+		VersionIDs:      res.versionIDs,
+		MilestoneIDs:    res.milestoneIDs,
+		PriorityID:      res.priorityID,
+		AssigneeID:      res.assigneeID,
+		NotifiedUserIDs: res.notifiedUserIDs,
+		CustomFields:    res.customFields,
+	}, nil
+}
+
+// UpdateIssueInput converts an IssueSpec into UpdateIssueInput
+func (r *Resolver) UpdateIssueInput(spec *IssueSpec) (*UpdateIssueInput, error) {
+	return r.UpdateIssueInputContext(context.Background(), spec)
+}
+
+// UpdateIssueInputContext converts an IssueSpec into UpdateIssueInput with context
+func (r *Resolver) UpdateIssueInputContext(ctx context.Context, spec *IssueSpec) (*UpdateIssueInput, error) {
+	res, err := r.resolveIssueSpec(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &UpdateIssueInput{
+		Summary:         spec.Summary,
+		ParentIssueID:   spec.ParentIssueID,
+		Description:     spec.Description,
+		StatusID:        res.statusID,
+		StartDate:       spec.StartDate,
+		DueDate:         spec.DueDate,
+		IssueTypeID:     res.issueTypeID,
+		CategoryIDs:     res.categoryIDs,
+		VersionIDs:      res.versionIDs,
+		MilestoneIDs:    res.milestoneIDs,
+		PriorityID:      res.priorityID,
+		NotifiedUserIDs: res.notifiedUserIDs,
+		Comment:         spec.Comment,
+		CustomFields:    res.customFields,
+	}
+	// interface{} fields must stay nil to be omitted
+	if res.resolutionID != nil {
+		input.ResolutionID = *res.resolutionID
+	}
+	if res.assigneeID != nil {
+		input.AssigneeID = *res.assigneeID
+	}
+	if spec.EstimatedHours != nil {
+		input.EstimatedHours = *spec.EstimatedHours
+	}
+	if spec.ActualHours != nil {
+		input.ActualHours = *spec.ActualHours
+	}
+	return input, nil
+}
@@ -0,0 +1,239 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupResolver(t *testing.T) (*Resolver, func()) {
+	t.Helper()
+	client, mux, _, teardown := setup()
+
+	handle := func(path, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			if _, err := fmt.Fprint(w, body); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	handle("/projects/TEST", `{"id": 1, "projectKey": "TEST", "name": "test"}`)
+	handle("/projects/TEST/statuses", `[
+		{"id": 1, "projectId": 1, "name": "未対応"},
+		{"id": 2, "projectId": 1, "name": "処理中"},
+		{"id": 3, "projectId": 1, "name": "処理済み"},
+		{"id": 4, "projectId": 1, "name": "完了"}
+	]`)
+	handle("/projects/TEST/issueTypes", `[
+		{"id": 10, "projectId": 1, "name": "Bug"},
+		{"id": 11, "projectId": 1, "name": "タスク"},
+		{"id": 12, "projectId": 1, "name": "ｼｮﾘ"}
+	]`)
+	handle("/projects/TEST/categories", `[
+		{"id": 20, "name": "Frontend"},
+		{"id": 21, "name": "frontend"},
+		{"id": 22, "name": "Backend"}
+	]`)
+	handle("/projects/TEST/versions", `[
+		{"id": 30, "projectId": 1, "name": "v1.0"},
+		{"id": 31, "projectId": 1, "name": "Sprint 1"}
+	]`)
+	handle("/priorities", `[
+		{"id": 2, "name": "高"},
+		{"id": 3, "name": "中"},
+		{"id": 4, "name": "低"}
+	]`)
+	handle("/resolutions", `[
+		{"id": 0, "name": "対応済み"},
+		{"id": 1, "name": "対応しない"}
+	]`)
+	handle("/projects/TEST/customFields", `[
+		{"id": 40, "typeId": 1, "name": "Note"},
+		{"id": 41, "typeId": 6, "name": "OS", "items": [
+			{"id": 1, "name": "Windows"},
+			{"id": 2, "name": "macOS"}
+		]}
+	]`)
+	handle("/projects/TEST/users", `[
+		{"id": 50, "userId": "taro", "name": "山田 太郎", "roleType": 2},
+		{"id": 51, "userId": "hanako", "name": "佐藤 花子", "roleType": 2}
+	]`)
+
+	return client.NewResolver("TEST"), teardown
+}
+
+func TestNormalizeName(t *testing.T) {
+	assert.Equal(t, 0xff9f-0xff61+1, len(halfWidthKatakana))
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "Bug", want: "bug"},
+		{in: "ＢＵＧ", want: "bug"},
+		{in: " Sprint　 1 ", want: "sprint 1"},
+		{in: "ｼｮﾘﾁｭｳ", want: "ショリチュウ"},
+		{in: "ﾃﾞﾊﾞｯｸﾞ", want: "デバッグ"},
+		{in: "ﾎﾟｲﾝﾄ", want: "ポイント"},
+		{in: "ｳﾞｧ", want: "ヴァ"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeName(tt.in))
+		})
+	}
+}
+
+func TestResolver(t *testing.T) {
+	r, teardown := setupResolver(t)
+	defer teardown()
+
+	status, err := r.Status("処理中")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *status.ID)
+
+	issueType, err := r.IssueType("ＢＵＧ")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, *issueType.ID)
+
+	issueType, err = r.IssueType("ショリ")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, *issueType.ID)
+
+	// an exact match wins over case-insensitive matches
+	category, err := r.Category("frontend")
+	assert.NoError(t, err)
+	assert.Equal(t, 21, *category.ID)
+
+	milestone, err := r.Milestone("sprint 1")
+	assert.NoError(t, err)
+	assert.Equal(t, 31, *milestone.ID)
+
+	priority, err := r.Priority("高")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *priority.ID)
+
+	resolution, err := r.Resolution("対応済み")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, *resolution.ID)
+
+	item, err := r.CustomFieldItem("os", "MACOS")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *item.ID)
+
+	user, err := r.User("taro")
+	assert.NoError(t, err)
+	assert.Equal(t, 50, *user.ID)
+
+	user, err = r.User("佐藤 花子")
+	assert.NoError(t, err)
+	assert.Equal(t, 51, *user.ID)
+
+	project, err := r.Project()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *project.ID)
+}
+
+func TestResolverErrors(t *testing.T) {
+	r, teardown := setupResolver(t)
+	defer teardown()
+
+	_, err := r.Status("unknown")
+	var notFound *NameNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected NameNotFoundError but got %v", err)
+	}
+	assert.Equal(t, "status", notFound.Kind)
+	assert.EqualError(t, err, `status "unknown" is not found`)
+
+	_, err = r.Category("FRONTEND")
+	var ambiguous *AmbiguousNameError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected AmbiguousNameError but got %v", err)
+	}
+	assert.Equal(t, []string{"Frontend", "frontend"}, ambiguous.Candidates)
+}
+
+func TestResolverFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/TEST/statuses", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	r := client.NewResolver("TEST")
+	if _, err := r.Status("未対応"); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestResolver_CreateIssueInput(t *testing.T) {
+	r, teardown := setupResolver(t)
+	defer teardown()
+
+	input, err := r.CreateIssueInput(&IssueSpec{
+		Summary:       String("summary"),
+		IssueType:     String("bug"),
+		Priority:      String("中"),
+		Assignee:      String("taro"),
+		Categories:    []string{"Backend"},
+		Milestones:    []string{"Sprint 1"},
+		NotifiedUsers: []string{"hanako"},
+		CustomFields: map[string]interface{}{
+			"OS":   []string{"Windows", "macOS"},
+			"Note": "note",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	assert.Equal(t, 1, *input.ProjectID)
+	assert.Equal(t, 10, *input.IssueTypeID)
+	assert.Equal(t, 3, *input.PriorityID)
+	assert.Equal(t, 50, *input.AssigneeID)
+	assert.Equal(t, []int{22}, input.CategoryIDs)
+	assert.Equal(t, []int{31}, input.MilestoneIDs)
+	assert.Equal(t, []int{51}, input.NotifiedUserIDs)
+	assert.Equal(t, "customField_40=note&customField_41=1&customField_41=2",
+		createQueryStringsFromIssueCustomFields(input.CustomFields))
+
+	_, err = r.CreateIssueInput(&IssueSpec{Status: String("処理中")})
+	assert.Error(t, err)
+
+	_, err = r.CreateIssueInput(&IssueSpec{CustomFields: map[string]interface{}{"OS": 1}})
+	assert.Error(t, err)
+}
+
+func TestResolver_UpdateIssueInput(t *testing.T) {
+	r, teardown := setupResolver(t)
+	defer teardown()
+
+	input, err := r.UpdateIssueInput(&IssueSpec{
+		Status:         String("完了"),
+		Resolution:     String("対応済み"),
+		Assignee:       String("hanako"),
+		EstimatedHours: Float64(1.5),
+		Comment:        String("done"),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	b, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{
+		"statusId": 4,
+		"resolutionId": 0,
+		"assigneeId": 51,
+		"estimatedHours": 1.5,
+		"comment": "done"
+	}`, string(b))
+}
@@ -0,0 +1,210 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxSharedFileCount is the maximum count of shared files in a request
+const maxSharedFileCount = 1000
+
+// SharedFile types
+const (
+	SharedFileTypeFile      = "file"
+	SharedFileTypeDirectory = "directory"
+)
+
+// GetSharedFiles returns the list of shared files in a directory of a project
+func (c *Client) GetSharedFiles(projectIDOrKey interface{}, dir string, opts *GetSharedFilesOptions) ([]*SharedFile, error) {
+	return c.GetSharedFilesContext(context.Background(), projectIDOrKey, dir, opts)
+}
+
+// GetSharedFilesContext returns the list of shared files in a directory of a project with context
+func (c *Client) GetSharedFilesContext(ctx context.Context, projectIDOrKey interface{}, dir string, opts *GetSharedFilesOptions, callOpts ...CallOption) ([]*SharedFile, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/projects/%v/files/metadata/%s", projectIDOrKey, escapeSharedFilePath(dir))
+
+	u, err := c.AddOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedFiles := []*SharedFile{}
+	if err := c.Do(ctx, req, &sharedFiles); err != nil {
+		return nil, err
+	}
+	return sharedFiles, nil
+}
+
+// GetSharedFile downloads a shared file
+func (c *Client) GetSharedFile(projectIDOrKey interface{}, sharedFileID int, writer io.Writer) error {
+	return c.GetSharedFileContext(context.Background(), projectIDOrKey, sharedFileID, writer)
+}
+
+// GetSharedFileContext downloads a shared file with context
+func (c *Client) GetSharedFileContext(ctx context.Context, projectIDOrKey interface{}, sharedFileID int, writer io.Writer, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/projects/%v/files/%v", projectIDOrKey, sharedFileID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, writer); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WalkSharedFiles calls fn for each shared file and directory under dir of a
+// project, in the order of the listings. When fn returns fs.SkipDir for a
+// directory, the directory is not walked. Any other error stops the walk.
+func (c *Client) WalkSharedFiles(projectIDOrKey interface{}, dir string, fn func(*SharedFile) error) error {
+	return c.WalkSharedFilesContext(context.Background(), projectIDOrKey, dir, fn)
+}
+
+// WalkSharedFilesContext walks shared files with context
+func (c *Client) WalkSharedFilesContext(ctx context.Context, projectIDOrKey interface{}, dir string, fn func(*SharedFile) error, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+
+	for offset := 0; ; offset += maxSharedFileCount {
+		sharedFiles, err := c.GetSharedFilesContext(ctx, projectIDOrKey, dir, &GetSharedFilesOptions{
+			Order:  OrderAsc,
+			Offset: Int(offset),
+			Count:  Int(maxSharedFileCount),
+		})
+		if err != nil {
+			return err
+		}
+		for _, f := range sharedFiles {
+			err := fn(f)
+			isDir := derefString(f.Type) == SharedFileTypeDirectory
+			if isDir && err == fs.SkipDir {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if isDir {
+				if err := c.WalkSharedFilesContext(ctx, projectIDOrKey, sharedFilePath(f), fn); err != nil {
+					return err
+				}
+			}
+		}
+		if len(sharedFiles) < maxSharedFileCount {
+			return nil
+		}
+	}
+}
+
+// MirrorSharedFiles downloads the shared files under dir of a project into
+// localDir, keeping the directory structure. Files whose size and modification
+// time match the shared file are skipped, and the modification time of the
+// downloaded files is set to the updated time of the shared files.
+func (c *Client) MirrorSharedFiles(projectIDOrKey interface{}, dir, localDir string) error {
+	return c.MirrorSharedFilesContext(context.Background(), projectIDOrKey, dir, localDir)
+}
+
+// MirrorSharedFilesContext mirrors shared files to a local directory with context
+func (c *Client) MirrorSharedFilesContext(ctx context.Context, projectIDOrKey interface{}, dir, localDir string, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+	root := path.Clean("/" + dir)
+
+	return c.WalkSharedFilesContext(ctx, projectIDOrKey, dir, func(f *SharedFile) error {
+		rel := strings.TrimPrefix(strings.TrimPrefix(sharedFilePath(f), root), "/")
+		if !filepath.IsLocal(rel) {
+			return errors.Errorf("shared file %q is out of %q", sharedFilePath(f), root)
+		}
+		lpath := filepath.Join(localDir, filepath.FromSlash(rel))
+
+		if derefString(f.Type) == SharedFileTypeDirectory {
+			return os.MkdirAll(lpath, 0o755)
+		}
+		if f.ID == nil {
+			return nil
+		}
+
+		updated := f.Updated
+		if updated == nil {
+			updated = f.Created
+		}
+		if info, err := os.Stat(lpath); err == nil && f.Size != nil && updated != nil &&
+			info.Size() == int64(*f.Size) && info.ModTime().Unix() == updated.Unix() {
+			c.Debugf("skip unchanged shared file %s", lpath)
+			return nil
+		}
+
+		if err := os.MkdirAll(filepath.Dir(lpath), 0o755); err != nil {
+			return err
+		}
+		if err := c.downloadSharedFile(ctx, projectIDOrKey, *f.ID, lpath); err != nil {
+			return err
+		}
+		if updated != nil {
+			return os.Chtimes(lpath, updated.Time, updated.Time)
+		}
+		return nil
+	})
+}
+
+// downloadSharedFile downloads a shared file via a temporary file, so that an
+// interrupted download does not leave a partial file at lpath
+func (c *Client) downloadSharedFile(ctx context.Context, projectIDOrKey interface{}, sharedFileID int, lpath string) (err error) {
+	tmp, err := os.CreateTemp(filepath.Dir(lpath), "."+filepath.Base(lpath)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if err = c.GetSharedFileContext(ctx, projectIDOrKey, sharedFileID, tmp); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), lpath)
+}
+
+// sharedFilePath returns the absolute path of a shared file
+func sharedFilePath(f *SharedFile) string {
+	return path.Join("/", derefString(f.Dir), derefString(f.Name))
+}
+
+// escapeSharedFilePath escapes each element of a directory path
+func escapeSharedFilePath(dir string) string {
+	elems := strings.Split(strings.Trim(path.Clean("/"+dir), "/"), "/")
+	for i, e := range elems {
+		elems[i] = url.PathEscape(e)
+	}
+	return strings.Join(elems, "/")
+}
+
+// GetSharedFilesOptions specifies parameters to the GetSharedFiles method.
+type GetSharedFilesOptions struct {
+	Order  Order `url:"order,omitempty"`
+	Offset *int  `url:"offset,omitempty"`
+	Count  *int  `url:"count,omitempty"`
+}
@@ -0,0 +1,131 @@
+package backlog
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSharedFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/TEST/files/metadata/docs/my%20dir", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "10", r.URL.Query().Get("count"))
+		if _, err := fmt.Fprint(w, `[{"id": 1, "type": "file", "dir": "/docs/my dir/", "name": "a.txt", "size": 5}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	files, err := client.GetSharedFiles("TEST", "/docs/my dir/", &GetSharedFilesOptions{Count: Int(10)})
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	assert.Equal(t, "a.txt", *files[0].Name)
+	assert.Equal(t, "/docs/my dir/a.txt", sharedFilePath(files[0]))
+}
+
+func TestGetSharedFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/projects/TEST/files/1", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := w.Write([]byte("hello")); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var buf bytes.Buffer
+	assert.NoError(t, client.GetSharedFile("TEST", 1, &buf))
+	assert.Equal(t, "hello", buf.String())
+}
+
+func setupSharedFileTree(t *testing.T, mux *http.ServeMux) *int32 {
+	t.Helper()
+	handle := func(pattern, body string) {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, _ *http.Request) {
+			if _, err := fmt.Fprint(w, body); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	handle("/projects/TEST/files/metadata/docs", `[
+		{"id": 1, "type": "file", "dir": "/docs/", "name": "a.txt", "size": 5, "updated": "2006-01-02T15:04:05Z"},
+		{"id": 2, "type": "directory", "dir": "/docs/", "name": "sub"}
+	]`)
+	handle("/projects/TEST/files/metadata/docs/sub", `[
+		{"id": 3, "type": "file", "dir": "/docs/sub/", "name": "b.txt", "size": 2, "updated": "2006-01-02T15:04:05Z"}
+	]`)
+
+	var downloads int32
+	for id, body := range map[int]string{1: "hello", 3: "hi"} {
+		mux.HandleFunc(fmt.Sprintf("/projects/TEST/files/%d", id), func(w http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&downloads, 1)
+			if _, err := fmt.Fprint(w, body); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	return &downloads
+}
+
+func TestWalkSharedFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupSharedFileTree(t, mux)
+
+	var paths []string
+	err := client.WalkSharedFiles("TEST", "/docs", func(f *SharedFile) error {
+		paths = append(paths, sharedFilePath(f))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/docs/a.txt", "/docs/sub", "/docs/sub/b.txt"}, paths)
+
+	paths = nil
+	err = client.WalkSharedFiles("TEST", "/docs", func(f *SharedFile) error {
+		paths = append(paths, sharedFilePath(f))
+		if *f.Type == SharedFileTypeDirectory {
+			return fs.SkipDir
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/docs/a.txt", "/docs/sub"}, paths)
+}
+
+func TestMirrorSharedFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	downloads := setupSharedFileTree(t, mux)
+
+	dir := t.TempDir()
+	assert.NoError(t, client.MirrorSharedFiles("TEST", "/docs/", dir))
+	assert.Equal(t, int32(2), atomic.LoadInt32(downloads))
+
+	b, err := os.ReadFile(filepath.Join(dir, "a.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+	b, err = os.ReadFile(filepath.Join(dir, "sub", "b.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hi", string(b))
+	info, err := os.Stat(filepath.Join(dir, "a.txt"))
+	assert.NoError(t, err)
+	assert.True(t, info.ModTime().Equal(referenceTime))
+
+	// unchanged files are skipped
+	assert.NoError(t, client.MirrorSharedFiles("TEST", "/docs/", dir))
+	assert.Equal(t, int32(2), atomic.LoadInt32(downloads))
+
+	// a modified file is downloaded again
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("bye"), 0o644))
+	assert.NoError(t, client.MirrorSharedFiles("TEST", "/docs/", dir))
+	assert.Equal(t, int32(3), atomic.LoadInt32(downloads))
+}
@@ -90,7 +90,8 @@ func (c *Client) GetSpace() (*Space, error) {
 }
 
 // GetSpaceContext returns backlog space with context
-func (c *Client) GetSpaceContext(ctx context.Context) (*Space, error) {
+func (c *Client) GetSpaceContext(ctx context.Context, callOpts ...CallOption) (*Space, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/space"
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -111,7 +112,8 @@ func (c *Client) GetSpaceIcon(writer io.Writer) error {
 }
 
 // GetSpaceIconContext downloads space icon with context
-func (c *Client) GetSpaceIconContext(ctx context.Context, writer io.Writer) error {
+func (c *Client) GetSpaceIconContext(ctx context.Context, writer io.Writer, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/space/image"
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -131,7 +133,8 @@ func (c *Client) GetSpaceNotification() (*SpaceNotification, error) {
 }
 
 // GetSpaceNotificationContext returns a space notification with context
-func (c *Client) GetSpaceNotificationContext(ctx context.Context) (*SpaceNotification, error) {
+func (c *Client) GetSpaceNotificationContext(ctx context.Context, callOpts ...CallOption) (*SpaceNotification, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/space/notification"
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -152,7 +155,8 @@ func (c *Client) UpdateSpaceNotification(input *UpdateSpaceNotificationInput) (*
 }
 
 // UpdateSpaceNotificationContext updates a space notification with context
-func (c *Client) UpdateSpaceNotificationContext(ctx context.Context, input *UpdateSpaceNotificationInput) (*SpaceNotification, error) {
+func (c *Client) UpdateSpaceNotificationContext(ctx context.Context, input *UpdateSpaceNotificationInput, callOpts ...CallOption) (*SpaceNotification, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/space/notification"
 
 	req, err := c.NewRequest("PUT", u, input)
@@ -173,7 +177,8 @@ func (c *Client) GetSpaceDiskUsage() (*SpaceDiskUsage, error) {
 }
 
 // GetSpaceDiskUsageContext returns the disk usage of a space with context
-func (c *Client) GetSpaceDiskUsageContext(ctx context.Context) (*SpaceDiskUsage, error) {
+func (c *Client) GetSpaceDiskUsageContext(ctx context.Context, callOpts ...CallOption) (*SpaceDiskUsage, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/space/diskUsage"
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -194,7 +199,8 @@ func (c *Client) GetLicence() (*License, error) {
 }
 
 // GetLicenceContext returns the license information with context
-func (c *Client) GetLicenceContext(ctx context.Context) (*License, error) {
+func (c *Client) GetLicenceContext(ctx context.Context, callOpts ...CallOption) (*License, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/space/licence"
 
 	req, err := c.NewRequest("GET", u, nil)
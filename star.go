@@ -0,0 +1,146 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// StarTargetType : the type of the content to star
+type StarTargetType int
+
+// StarTargetType
+const (
+	StarTargetIssue = StarTargetType(iota + 1)
+	StarTargetComment
+	StarTargetWiki
+	StarTargetPullRequestComment
+)
+
+func (k StarTargetType) String() string {
+	switch k {
+	case StarTargetIssue:
+		return "issue"
+	case StarTargetComment:
+		return "comment"
+	case StarTargetWiki:
+		return "wiki"
+	case StarTargetPullRequestComment:
+		return "pull request comment"
+	default:
+		return fmt.Sprintf("StarTargetType(%d)", int(k))
+	}
+}
+
+// StarTarget : the content to star, specified by its type and ID
+type StarTarget struct {
+	Type StarTargetType
+	ID   int
+}
+
+// addStarInput : the parameters of AddStar, one of which is set
+type addStarInput struct {
+	IssueID              *int `json:"issueId,omitempty"`
+	CommentID            *int `json:"commentId,omitempty"`
+	WikiID               *int `json:"wikiId,omitempty"`
+	PullRequestCommentID *int `json:"pullRequestCommentId,omitempty"`
+}
+
+// AddStar adds a star to an issue, a comment, a wiki or a pull request comment
+func (c *Client) AddStar(target StarTarget) error {
+	return c.AddStarContext(context.Background(), target)
+}
+
+// AddStarContext adds a star to an issue, a comment, a wiki or a pull request comment with context
+func (c *Client) AddStarContext(ctx context.Context, target StarTarget, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+	u := "/api/v2/stars"
+
+	input := &addStarInput{}
+	switch target.Type {
+	case StarTargetIssue:
+		input.IssueID = Int(target.ID)
+	case StarTargetComment:
+		input.CommentID = Int(target.ID)
+	case StarTargetWiki:
+		input.WikiID = Int(target.ID)
+	case StarTargetPullRequestComment:
+		input.PullRequestCommentID = Int(target.ID)
+	default:
+		return errors.Errorf("unknown star target type: %v", target.Type)
+	}
+
+	req, err := c.NewRequest("POST", u, input)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoveStar removes a star
+func (c *Client) RemoveStar(starID int) error {
+	return c.RemoveStarContext(context.Background(), starID)
+}
+
+// RemoveStarContext removes a star with context
+func (c *Client) RemoveStarContext(ctx context.Context, starID int, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/stars/%v", starID)
+
+	req, err := c.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Do(ctx, req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetStarCountsByUser returns the count of stars each user received between since and until.
+// The zero since or until is unbounded, and the dates are compared in the
+// location of the times. If userIDs is empty, the counts of all users are returned.
+func (c *Client) GetStarCountsByUser(userIDs []int, since, until time.Time) (map[int]int, error) {
+	return c.GetStarCountsByUserContext(context.Background(), userIDs, since, until)
+}
+
+// GetStarCountsByUserContext returns the count of stars each user received between since and until with context
+func (c *Client) GetStarCountsByUserContext(ctx context.Context, userIDs []int, since, until time.Time, callOpts ...CallOption) (map[int]int, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	if len(userIDs) == 0 {
+		users, err := c.GetUsersContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			if user.ID != nil {
+				userIDs = append(userIDs, *user.ID)
+			}
+		}
+	}
+
+	opts := &GetUserStarCountOptions{}
+	if !since.IsZero() {
+		opts.Since = String(since.Format(issueQueryDateLayout))
+	}
+	if !until.IsZero() {
+		opts.Until = String(until.Format(issueQueryDateLayout))
+	}
+
+	counts := make(map[int]int, len(userIDs))
+	for _, id := range userIDs {
+		count, err := c.GetUserStarCountContext(ctx, id, opts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to count the stars of user %d", id)
+		}
+		counts[id] = count
+	}
+	return counts, nil
+}
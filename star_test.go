@@ -0,0 +1,86 @@
+package backlog
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddStar(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var body string
+	mux.HandleFunc("/stars", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		body = string(b)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tests := []struct {
+		target StarTarget
+		want   string
+	}{
+		{StarTarget{Type: StarTargetIssue, ID: 1}, `{"issueId": 1}`},
+		{StarTarget{Type: StarTargetComment, ID: 2}, `{"commentId": 2}`},
+		{StarTarget{Type: StarTargetWiki, ID: 3}, `{"wikiId": 3}`},
+		{StarTarget{Type: StarTargetPullRequestComment, ID: 4}, `{"pullRequestCommentId": 4}`},
+	}
+	for _, tt := range tests {
+		assert.NoError(t, client.AddStar(tt.target))
+		assert.JSONEq(t, tt.want, body)
+	}
+
+	assert.Error(t, client.AddStar(StarTarget{ID: 1}))
+}
+
+func TestRemoveStar(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stars/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assert.NoError(t, client.RemoveStar(1))
+}
+
+func TestGetStarCountsByUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	for id, count := range map[int]int{1: 3, 2: 5} {
+		mux.HandleFunc(fmt.Sprintf("/users/%d/stars/count", id), func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2024-04-01", r.URL.Query().Get("since"))
+			assert.Equal(t, "2024-04-30", r.URL.Query().Get("until"))
+			if _, err := fmt.Fprintf(w, `{"count": %d}`, count); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	since := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+	counts, err := client.GetStarCountsByUser(nil, since, until)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]int{1: 3, 2: 5}, counts)
+
+	counts, err = client.GetStarCountsByUser([]int{2}, since, until)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]int{2: 5}, counts)
+
+	_, err = client.GetStarCountsByUser([]int{3}, since, until)
+	assert.Error(t, err)
+}
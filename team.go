@@ -24,7 +24,8 @@ func (c *Client) GetTeams(opts *GetTeamsOptions) ([]*Team, error) {
 }
 
 // GetTeamsContext returns the list of teams with context
-func (c *Client) GetTeamsContext(ctx context.Context, opts *GetTeamsOptions) ([]*Team, error) {
+func (c *Client) GetTeamsContext(ctx context.Context, opts *GetTeamsOptions, callOpts ...CallOption) ([]*Team, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u, err := c.AddOptions("/api/v2/teams", opts)
 	if err != nil {
 		return nil, err
@@ -50,7 +51,8 @@ func (c *Client) CreateTeam(input *CreateTeamInput) (*Team, error) {
 
 // CreateTeamContext creates a team with Context
 // a space backlog.com cannot use this API
-func (c *Client) CreateTeamContext(ctx context.Context, input *CreateTeamInput) (*Team, error) {
+func (c *Client) CreateTeamContext(ctx context.Context, input *CreateTeamInput, callOpts ...CallOption) (*Team, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/teams"
 
 	req, err := c.NewRequest("POST", u, input)
@@ -71,7 +73,8 @@ func (c *Client) GetTeam(teamID int) (*Team, error) {
 }
 
 // GetTeamContext returns a team with context
-func (c *Client) GetTeamContext(ctx context.Context, teamID int) (*Team, error) {
+func (c *Client) GetTeamContext(ctx context.Context, teamID int, callOpts ...CallOption) (*Team, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/teams/%v", teamID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -92,7 +95,8 @@ func (c *Client) UpdateTeam(teamID int, input *UpdateTeamInput) (*Team, error) {
 }
 
 // UpdateTeamContext updates a team with Context
-func (c *Client) UpdateTeamContext(ctx context.Context, teamID int, input *UpdateTeamInput) (*Team, error) {
+func (c *Client) UpdateTeamContext(ctx context.Context, teamID int, input *UpdateTeamInput, callOpts ...CallOption) (*Team, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/teams/%v", teamID)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -113,7 +117,8 @@ func (c *Client) DeleteTeam(teamID int) (*Team, error) {
 }
 
 // DeleteTeamContext deletes a team with Context
-func (c *Client) DeleteTeamContext(ctx context.Context, teamID int) (*Team, error) {
+func (c *Client) DeleteTeamContext(ctx context.Context, teamID int, callOpts ...CallOption) (*Team, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/teams/%v", teamID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -134,7 +139,8 @@ func (c *Client) GetTeamIcon(teamID int, writer io.Writer) error {
 }
 
 // GetTeamIconContext downloads team icon with context
-func (c *Client) GetTeamIconContext(ctx context.Context, teamID int, writer io.Writer) error {
+func (c *Client) GetTeamIconContext(ctx context.Context, teamID int, writer io.Writer, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/teams/%v/icon", teamID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -154,7 +160,8 @@ func (c *Client) GetProjectTeams(projectIDOrKey interface{}) ([]*Team, error) {
 }
 
 // GetProjectTeamsContext returns the list of teams in a project with context
-func (c *Client) GetProjectTeamsContext(ctx context.Context, projectIDOrKey interface{}) ([]*Team, error) {
+func (c *Client) GetProjectTeamsContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) ([]*Team, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/teams", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -175,7 +182,8 @@ func (c *Client) AddProjectTeam(projectIDOrKey interface{}, input *AddProjectTea
 }
 
 // AddProjectTeamContext adds a team to a project with context
-func (c *Client) AddProjectTeamContext(ctx context.Context, projectIDOrKey interface{}, input *AddProjectTeamInput) (*Team, error) {
+func (c *Client) AddProjectTeamContext(ctx context.Context, projectIDOrKey interface{}, input *AddProjectTeamInput, callOpts ...CallOption) (*Team, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/teams", projectIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -196,7 +204,8 @@ func (c *Client) DeleteProjectTeam(projectIDOrKey interface{}, input *DeleteProj
 }
 
 // DeleteProjectTeamContext deletes a team to a project with context
-func (c *Client) DeleteProjectTeamContext(ctx context.Context, projectIDOrKey interface{}, input *DeleteProjectTeamInput) (*Team, error) {
+func (c *Client) DeleteProjectTeamContext(ctx context.Context, projectIDOrKey interface{}, input *DeleteProjectTeamInput, callOpts ...CallOption) (*Team, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/teams", projectIDOrKey)
 
 	req, err := c.NewRequest("DELETE", u, input)
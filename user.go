@@ -71,21 +71,18 @@ type User struct {
 
 // Notification : -
 type Notification struct {
-	ID                  *int  `json:"id,omitempty"`
-	AlreadyRead         *bool `json:"alreadyRead,omitempty"`
-	Reason              *int  `json:"reason,omitempty"`
-	User                *User `json:"user,omitempty"`
-	ResourceAlreadyRead *bool `json:"resourceAlreadyRead,omitempty"`
-}
-
-// Content : -
-type Content struct {
-	ID          *int      `json:"id,omitempty"`
-	KeyID       *int      `json:"key_id,omitempty"`
-	Summary     *string   `json:"summary,omitempty"`
-	Description *string   `json:"description,omitempty"`
-	Comment     *Comment  `json:"comment,omitempty"`
-	Changes     []*Change `json:"changes,omitempty"`
+	ID                  *int                `json:"id,omitempty"`
+	AlreadyRead         *bool               `json:"alreadyRead,omitempty"`
+	Reason              *int                `json:"reason,omitempty"`
+	User                *User               `json:"user,omitempty"`
+	ResourceAlreadyRead *bool               `json:"resourceAlreadyRead,omitempty"`
+	Project             *Project            `json:"project,omitempty"`
+	Issue               *Issue              `json:"issue,omitempty"`
+	Comment             *IssueComment       `json:"comment,omitempty"`
+	PullRequest         *PullRequest        `json:"pullRequest,omitempty"`
+	PullRequestComment  *PullRequestComment `json:"pullRequestComment,omitempty"`
+	Sender              *User               `json:"sender,omitempty"`
+	Created             *Timestamp          `json:"created,omitempty"`
 }
 
 // Comment : -
@@ -114,7 +111,8 @@ func (c *Client) GetUserMySelf() (*User, error) {
 }
 
 // GetUserMySelfContext will retrieve the complete my user information by id with a custom context
-func (c *Client) GetUserMySelfContext(ctx context.Context) (*User, error) {
+func (c *Client) GetUserMySelfContext(ctx context.Context, callOpts ...CallOption) (*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/users/myself"
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -135,7 +133,8 @@ func (c *Client) GetUser(id int) (*User, error) {
 }
 
 // GetUserContext will retrieve the complete user information by id with a custom context
-func (c *Client) GetUserContext(ctx context.Context, id int) (*User, error) {
+func (c *Client) GetUserContext(ctx context.Context, id int, callOpts ...CallOption) (*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/users/%v", id)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -156,7 +155,8 @@ func (c *Client) GetUsers() ([]*User, error) {
 }
 
 // GetUsersContext returns the list of users
-func (c *Client) GetUsersContext(ctx context.Context) ([]*User, error) {
+func (c *Client) GetUsersContext(ctx context.Context, callOpts ...CallOption) ([]*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/users"
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -177,7 +177,8 @@ func (c *Client) CreateUser(input *CreateUserInput) (*User, error) {
 }
 
 // CreateUserContext creates a user with Context
-func (c *Client) CreateUserContext(ctx context.Context, input *CreateUserInput) (*User, error) {
+func (c *Client) CreateUserContext(ctx context.Context, input *CreateUserInput, callOpts ...CallOption) (*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/users"
 
 	req, err := c.NewRequest("POST", u, input)
@@ -198,7 +199,8 @@ func (c *Client) UpdateUser(id int, input *UpdateUserInput) (*User, error) {
 }
 
 // UpdateUserContext updates a user with Context
-func (c *Client) UpdateUserContext(ctx context.Context, id int, input *UpdateUserInput) (*User, error) {
+func (c *Client) UpdateUserContext(ctx context.Context, id int, input *UpdateUserInput, callOpts ...CallOption) (*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/users/%v", id)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -219,7 +221,8 @@ func (c *Client) DeleteUser(id int) (*User, error) {
 }
 
 // DeleteUserContext deletes a user with Context
-func (c *Client) DeleteUserContext(ctx context.Context, id int) (*User, error) {
+func (c *Client) DeleteUserContext(ctx context.Context, id int, callOpts ...CallOption) (*User, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/users/%v", id)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -240,7 +243,8 @@ func (c *Client) GetUserIcon(id int, writer io.Writer) error {
 }
 
 // GetUserIconContext downloads user icon with context
-func (c *Client) GetUserIconContext(ctx context.Context, id int, writer io.Writer) error {
+func (c *Client) GetUserIconContext(ctx context.Context, id int, writer io.Writer, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/users/%v/icon", id)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -261,7 +265,8 @@ func (c *Client) GetUserStars(id int, opts *GetUserStarsOptions) ([]*Star, error
 }
 
 // GetUserStarsContext returns the list of a user's activities with context
-func (c *Client) GetUserStarsContext(ctx context.Context, id int, opts *GetUserStarsOptions) ([]*Star, error) {
+func (c *Client) GetUserStarsContext(ctx context.Context, id int, opts *GetUserStarsOptions, callOpts ...CallOption) ([]*Star, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/users/%v/stars", id)
 
 	u, err := c.AddOptions(u, opts)
@@ -287,7 +292,8 @@ func (c *Client) GetUserStarCount(id int, opts *GetUserStarCountOptions) (int, e
 }
 
 // GetUserStarCountContext returns the count of stars with context
-func (c *Client) GetUserStarCountContext(ctx context.Context, id int, opts *GetUserStarCountOptions) (int, error) {
+func (c *Client) GetUserStarCountContext(ctx context.Context, id int, opts *GetUserStarCountOptions, callOpts ...CallOption) (int, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/users/%v/stars/count", id)
 
 	u, err := c.AddOptions(u, opts)
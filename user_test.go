@@ -144,7 +144,7 @@ func getTestActivity() *Activity {
 			Archived:                          Bool(false),
 			DisplayOrder:                      Int(0),
 		},
-		Type: Int(2),
+		Type: (*ActivityType)(Int(2)),
 		Content: &Content{
 			ID:          Int(4809),
 			KeyID:       Int(121),
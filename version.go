@@ -23,7 +23,8 @@ func (c *Client) GetVersions(projectIDOrKey interface{}) ([]*Version, error) {
 }
 
 // GetVersionsContext returns a version of a project with context
-func (c *Client) GetVersionsContext(ctx context.Context, projectIDOrKey interface{}) ([]*Version, error) {
+func (c *Client) GetVersionsContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) ([]*Version, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/versions", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -44,7 +45,8 @@ func (c *Client) CreateVersion(projectIDOrKey interface{}, input *CreateVersionI
 }
 
 // CreateVersionContext creates a versions (milestone) of a project with Context
-func (c *Client) CreateVersionContext(ctx context.Context, projectIDOrKey interface{}, input *CreateVersionInput) (*Version, error) {
+func (c *Client) CreateVersionContext(ctx context.Context, projectIDOrKey interface{}, input *CreateVersionInput, callOpts ...CallOption) (*Version, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/versions", projectIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -65,7 +67,8 @@ func (c *Client) UpdateVersion(projectIDOrKey interface{}, versionID int, input
 }
 
 // UpdateVersionContext updates a versions (milestone) of a project with Context
-func (c *Client) UpdateVersionContext(ctx context.Context, projectIDOrKey interface{}, versionID int, input *UpdateVersionInput) (*Version, error) {
+func (c *Client) UpdateVersionContext(ctx context.Context, projectIDOrKey interface{}, versionID int, input *UpdateVersionInput, callOpts ...CallOption) (*Version, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/versions/%v", projectIDOrKey, versionID)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -86,7 +89,8 @@ func (c *Client) DeleteVersion(projectIDOrKey interface{}, versionID int) (*Vers
 }
 
 // DeleteVersionContext deletes a versions (milestone) of a project with Context
-func (c *Client) DeleteVersionContext(ctx context.Context, projectIDOrKey interface{}, versionID int) (*Version, error) {
+func (c *Client) DeleteVersionContext(ctx context.Context, projectIDOrKey interface{}, versionID int, callOpts ...CallOption) (*Version, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/versions/%v", projectIDOrKey, versionID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -23,7 +23,8 @@ func (c *Client) GetUserWatchings(userID int) ([]*Watching, error) {
 }
 
 // GetUserWatchingsContext returns the list of user's watchings with context
-func (c *Client) GetUserWatchingsContext(ctx context.Context, userID int) ([]*Watching, error) {
+func (c *Client) GetUserWatchingsContext(ctx context.Context, userID int, callOpts ...CallOption) ([]*Watching, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/users/%v/watchings", userID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -44,7 +45,8 @@ func (c *Client) GetUserWatchingsCount(userID int, opts *GetUserWatchingsCountOp
 }
 
 // GetUserWatchingsCountContext returns the count of user's watchings with context
-func (c *Client) GetUserWatchingsCountContext(ctx context.Context, userID int, opts *GetUserWatchingsCountOptions) (int, error) {
+func (c *Client) GetUserWatchingsCountContext(ctx context.Context, userID int, opts *GetUserWatchingsCountOptions, callOpts ...CallOption) (int, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/users/%v/watchings/count", userID)
 
 	u, err := c.AddOptions(u, opts)
@@ -70,7 +72,8 @@ func (c *Client) GetWatching(watchingID int) (*Watching, error) {
 }
 
 // GetWatchingContext returns a watching with context
-func (c *Client) GetWatchingContext(ctx context.Context, watchingID int) (*Watching, error) {
+func (c *Client) GetWatchingContext(ctx context.Context, watchingID int, callOpts ...CallOption) (*Watching, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/watchings/%v", watchingID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -91,7 +94,8 @@ func (c *Client) CreateWatching(input *CreateWatchingInput) (*Watching, error) {
 }
 
 // CreateWatchingContext creates a watching with Context
-func (c *Client) CreateWatchingContext(ctx context.Context, input *CreateWatchingInput) (*Watching, error) {
+func (c *Client) CreateWatchingContext(ctx context.Context, input *CreateWatchingInput, callOpts ...CallOption) (*Watching, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/watchings"
 
 	req, err := c.NewRequest("POST", u, input)
@@ -112,7 +116,8 @@ func (c *Client) UpdateWatching(watchingID int, input *UpdateWatchingInput) (*Wa
 }
 
 // UpdateWatchingContext updates a watching with Context
-func (c *Client) UpdateWatchingContext(ctx context.Context, watchingID int, input *UpdateWatchingInput) (*Watching, error) {
+func (c *Client) UpdateWatchingContext(ctx context.Context, watchingID int, input *UpdateWatchingInput, callOpts ...CallOption) (*Watching, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/watchings/%v", watchingID)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -133,7 +138,8 @@ func (c *Client) DeleteWatching(watchingID int) (*Watching, error) {
 }
 
 // DeleteWatchingContext deletes a watching with Context
-func (c *Client) DeleteWatchingContext(ctx context.Context, watchingID int) (*Watching, error) {
+func (c *Client) DeleteWatchingContext(ctx context.Context, watchingID int, callOpts ...CallOption) (*Watching, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/watchings/%v", watchingID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -154,7 +160,8 @@ func (c *Client) MarkAsReadWatching(watchingID int) error {
 }
 
 // MarkAsReadWatchingContext marks a watching as read with Context
-func (c *Client) MarkAsReadWatchingContext(ctx context.Context, watchingID int) error {
+func (c *Client) MarkAsReadWatchingContext(ctx context.Context, watchingID int, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/watchings/%v/markAsRead", watchingID)
 
 	req, err := c.NewRequest("POST", u, nil)
@@ -26,7 +26,8 @@ func (c *Client) GetWebhook(projectIDOrKey interface{}, webhookID int) (*Webhook
 }
 
 // GetWebhookContext returns the list of webhooks with context
-func (c *Client) GetWebhookContext(ctx context.Context, projectIDOrKey interface{}, webhookID int) (*Webhook, error) {
+func (c *Client) GetWebhookContext(ctx context.Context, projectIDOrKey interface{}, webhookID int, callOpts ...CallOption) (*Webhook, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/webhooks/%v", projectIDOrKey, webhookID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -47,7 +48,8 @@ func (c *Client) GetWebhooks(projectIDOrKey interface{}) ([]*Webhook, error) {
 }
 
 // GetWebhooksContext returns the list of webhooks with context
-func (c *Client) GetWebhooksContext(ctx context.Context, projectIDOrKey interface{}) ([]*Webhook, error) {
+func (c *Client) GetWebhooksContext(ctx context.Context, projectIDOrKey interface{}, callOpts ...CallOption) ([]*Webhook, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/webhooks", projectIDOrKey)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -68,7 +70,8 @@ func (c *Client) CreateWebhook(projectIDOrKey interface{}, webhook *CreateWebhoo
 }
 
 // CreateWebhookContext adds a webhook with context
-func (c *Client) CreateWebhookContext(ctx context.Context, projectIDOrKey interface{}, input *CreateWebhookInput) (*Webhook, error) {
+func (c *Client) CreateWebhookContext(ctx context.Context, projectIDOrKey interface{}, input *CreateWebhookInput, callOpts ...CallOption) (*Webhook, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/webhooks", projectIDOrKey)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -89,7 +92,8 @@ func (c *Client) UpdateWebhook(projectIDOrKey interface{}, webhookID int, input
 }
 
 // UpdateWebhookContext updates a webhook with context
-func (c *Client) UpdateWebhookContext(ctx context.Context, projectIDOrKey interface{}, webhookID int, input *UpdateWebhookInput) (*Webhook, error) {
+func (c *Client) UpdateWebhookContext(ctx context.Context, projectIDOrKey interface{}, webhookID int, input *UpdateWebhookInput, callOpts ...CallOption) (*Webhook, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/webhooks/%v", projectIDOrKey, webhookID)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -110,7 +114,8 @@ func (c *Client) DeleteWebhook(projectIDOrKey interface{}, webhookID int) (*Webh
 }
 
 // DeleteWebhookContext updates a webhook with context
-func (c *Client) DeleteWebhookContext(ctx context.Context, projectIDOrKey interface{}, webhookID int) (*Webhook, error) {
+func (c *Client) DeleteWebhookContext(ctx context.Context, projectIDOrKey interface{}, webhookID int, callOpts ...CallOption) (*Webhook, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/projects/%v/webhooks/%v", projectIDOrKey, webhookID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -127,18 +132,18 @@ func (c *Client) DeleteWebhookContext(ctx context.Context, projectIDOrKey interf
 
 // CreateWebhookInput contains all the parameters necessary (including the optional ones) for a CreateWebhook() request.
 type CreateWebhookInput struct {
-	Name            *string `json:"name"`
-	Description     *string `json:"description,omitempty"`
-	HookURL         *string `json:"hookUrl"`
-	AllEvent        *bool   `json:"allEvent,omitempty"`
-	ActivityTypeIDs []int   `json:"activityTypeIds,omitempty"`
+	Name            *string        `json:"name"`
+	Description     *string        `json:"description,omitempty"`
+	HookURL         *string        `json:"hookUrl"`
+	AllEvent        *bool          `json:"allEvent,omitempty"`
+	ActivityTypeIDs []ActivityType `json:"activityTypeIds,omitempty"`
 }
 
 // UpdateWebhookInput contains all the parameters necessary (including the optional ones) for a UpdateWebhook() request.
 type UpdateWebhookInput struct {
-	Name            *string `json:"name,omitempty"`
-	Description     *string `json:"description,omitempty"`
-	HookURL         *string `json:"hookUrl,omitempty"`
-	AllEvent        *bool   `json:"allEvent,omitempty"`
-	ActivityTypeIDs []int   `json:"activityTypeIds,omitempty"`
+	Name            *string        `json:"name,omitempty"`
+	Description     *string        `json:"description,omitempty"`
+	HookURL         *string        `json:"hookUrl,omitempty"`
+	AllEvent        *bool          `json:"allEvent,omitempty"`
+	ActivityTypeIDs []ActivityType `json:"activityTypeIds,omitempty"`
 }
@@ -1,6 +1,7 @@
 package backlog
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -125,7 +126,7 @@ func TestCreateWebhook(t *testing.T) {
 		Description:     String(""),
 		HookURL:         String("https://webhook.example.com"),
 		AllEvent:        Bool(false),
-		ActivityTypeIDs: []int{1, 2, 3, 4, 5},
+		ActivityTypeIDs: []ActivityType{ActivityTypeIssueCreated, ActivityTypeIssueUpdated, ActivityTypeIssueCommented, ActivityTypeIssueDeleted, ActivityTypeWikiCreated},
 	}
 	webhook, err := client.CreateWebhook("SRE", input)
 	if err != nil {
@@ -228,7 +229,7 @@ func TestUpdateWebhook(t *testing.T) {
 		Description:     String(""),
 		HookURL:         String("https://webhook.example.com"),
 		AllEvent:        Bool(false),
-		ActivityTypeIDs: []int{1, 2, 3, 4, 5},
+		ActivityTypeIDs: []ActivityType{ActivityTypeIssueCreated, ActivityTypeIssueUpdated, ActivityTypeIssueCommented, ActivityTypeIssueDeleted, ActivityTypeWikiCreated},
 	}
 	webhook, err := client.UpdateWebhook("SRE", 10, input)
 	if err != nil {
@@ -353,3 +354,17 @@ func TestCreateWebhook_NewRequestError(t *testing.T) {
 
 	client.baseURL = originalBaseURL
 }
+
+func TestCreateWebhookInputActivityTypes(t *testing.T) {
+	b, err := json.Marshal(&CreateWebhookInput{
+		Name:            String("webhook"),
+		HookURL:         String("https://webhook.example.com"),
+		ActivityTypeIDs: []ActivityType{ActivityTypeIssueCreated, ActivityTypeGitPushed},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"activityTypeIds":[1,12]`) {
+		t.Errorf("activity types are not encoded as numbers: %s", b)
+	}
+}
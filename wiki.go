@@ -77,7 +77,8 @@ func (c *Client) GetMyRecentlyViewedWikis(opts *GetMyRecentlyViewedWikisOptions)
 }
 
 // GetMyRecentlyViewedWikisContext returns the list of wikis I recently viewed with context
-func (c *Client) GetMyRecentlyViewedWikisContext(ctx context.Context, opts *GetMyRecentlyViewedWikisOptions) ([]*RecentlyViewedWiki, error) {
+func (c *Client) GetMyRecentlyViewedWikisContext(ctx context.Context, opts *GetMyRecentlyViewedWikisOptions, callOpts ...CallOption) ([]*RecentlyViewedWiki, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u, err := c.AddOptions("/api/v2/users/myself/recentlyViewedWikis", opts)
 	if err != nil {
 		return nil, err
@@ -101,7 +102,8 @@ func (c *Client) GetWikis(opts *GetWikisOptions) ([]*Wiki, error) {
 }
 
 // GetWikisContext returns the list of wikis
-func (c *Client) GetWikisContext(ctx context.Context, opts *GetWikisOptions) ([]*Wiki, error) {
+func (c *Client) GetWikisContext(ctx context.Context, opts *GetWikisOptions, callOpts ...CallOption) ([]*Wiki, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u, err := c.AddOptions("/api/v2/wikis", opts)
 	if err != nil {
 		return nil, err
@@ -125,7 +127,8 @@ func (c *Client) GetWikiCount(opts *GetWikiCountOptions) (int, error) {
 }
 
 // GetWikiCountContext returns the number of wikis
-func (c *Client) GetWikiCountContext(ctx context.Context, opts *GetWikiCountOptions) (int, error) {
+func (c *Client) GetWikiCountContext(ctx context.Context, opts *GetWikiCountOptions, callOpts ...CallOption) (int, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u, err := c.AddOptions("/api/v2/wikis/count", opts)
 	if err != nil {
 		return 0, err
@@ -150,7 +153,8 @@ func (c *Client) GetWikiTags(opts *GetWikiTagsOptions) ([]*Tag, error) {
 }
 
 // GetWikiTagsContext returns the tags of wikis
-func (c *Client) GetWikiTagsContext(ctx context.Context, opts *GetWikiTagsOptions) ([]*Tag, error) {
+func (c *Client) GetWikiTagsContext(ctx context.Context, opts *GetWikiTagsOptions, callOpts ...CallOption) ([]*Tag, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u, err := c.AddOptions("/api/v2/wikis/tags", opts)
 	if err != nil {
 		return nil, err
@@ -174,7 +178,8 @@ func (c *Client) GetWiki(wikiID int) (*Wiki, error) {
 }
 
 // GetWikiContext returns wiki by id
-func (c *Client) GetWikiContext(ctx context.Context, wikiID int) (*Wiki, error) {
+func (c *Client) GetWikiContext(ctx context.Context, wikiID int, callOpts ...CallOption) (*Wiki, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/wikis/%v", wikiID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -195,7 +200,8 @@ func (c *Client) CreateWiki(input *CreateWikiInput) (*Wiki, error) {
 }
 
 // CreateWikiContext creates a wiki with Context
-func (c *Client) CreateWikiContext(ctx context.Context, input *CreateWikiInput) (*Wiki, error) {
+func (c *Client) CreateWikiContext(ctx context.Context, input *CreateWikiInput, callOpts ...CallOption) (*Wiki, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := "/api/v2/wikis"
 
 	req, err := c.NewRequest("POST", u, input)
@@ -216,7 +222,8 @@ func (c *Client) UpdateWiki(wikiID int, input *UpdateWikiInput) (*Wiki, error) {
 }
 
 // UpdateWikiContext updates a wiki with Context
-func (c *Client) UpdateWikiContext(ctx context.Context, wikiID int, input *UpdateWikiInput) (*Wiki, error) {
+func (c *Client) UpdateWikiContext(ctx context.Context, wikiID int, input *UpdateWikiInput, callOpts ...CallOption) (*Wiki, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/wikis/%v", wikiID)
 
 	req, err := c.NewRequest("PATCH", u, input)
@@ -237,7 +244,8 @@ func (c *Client) DeleteWiki(wikiID int) (*Wiki, error) {
 }
 
 // DeleteWikiContext deletes a wiki with Context
-func (c *Client) DeleteWikiContext(ctx context.Context, wikiID int) (*Wiki, error) {
+func (c *Client) DeleteWikiContext(ctx context.Context, wikiID int, callOpts ...CallOption) (*Wiki, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/wikis/%v", wikiID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -258,7 +266,8 @@ func (c *Client) GetWikiAttachments(wikiID int) ([]*Attachment, error) {
 }
 
 // GetWikiAttachmentsContext returns attachements of a wiki with context
-func (c *Client) GetWikiAttachmentsContext(ctx context.Context, wikiID int) ([]*Attachment, error) {
+func (c *Client) GetWikiAttachmentsContext(ctx context.Context, wikiID int, callOpts ...CallOption) ([]*Attachment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/wikis/%v/attachments", wikiID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -279,7 +288,8 @@ func (c *Client) GetWikiAttachmentContent(wikiID, attachmentID int, w io.Writer)
 }
 
 // GetWikiAttachmentContentContext writes the content to writer
-func (c *Client) GetWikiAttachmentContentContext(ctx context.Context, wikiID, attachmentID int, w io.Writer) error {
+func (c *Client) GetWikiAttachmentContentContext(ctx context.Context, wikiID, attachmentID int, w io.Writer, callOpts ...CallOption) error {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/wikis/%v/attachments/%v", wikiID, attachmentID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -299,7 +309,8 @@ func (c *Client) AddAttachmentToWiki(wikiID int, input *AddAttachmentToWikiInput
 }
 
 // AddAttachmentToWikiContext adds attachments to a wiki with context
-func (c *Client) AddAttachmentToWikiContext(ctx context.Context, wikiID int, input *AddAttachmentToWikiInput) ([]*Attachment, error) {
+func (c *Client) AddAttachmentToWikiContext(ctx context.Context, wikiID int, input *AddAttachmentToWikiInput, callOpts ...CallOption) ([]*Attachment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/wikis/%v/attachments", wikiID)
 
 	req, err := c.NewRequest("POST", u, input)
@@ -320,7 +331,8 @@ func (c *Client) DeleteAttachmentInWiki(wikiID, attachmentID int) (*Attachment,
 }
 
 // DeleteAttachmentInWikiContext deletes a attachment in a wiki with context
-func (c *Client) DeleteAttachmentInWikiContext(ctx context.Context, wikiID, attachmentID int) (*Attachment, error) {
+func (c *Client) DeleteAttachmentInWikiContext(ctx context.Context, wikiID, attachmentID int, callOpts ...CallOption) (*Attachment, error) {
+	ctx = withCallOptions(ctx, callOpts)
 	u := fmt.Sprintf("/api/v2/wikis/%v/attachments/%v", wikiID, attachmentID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -335,6 +347,94 @@ func (c *Client) DeleteAttachmentInWikiContext(ctx context.Context, wikiID, atta
 	return attachment, nil
 }
 
+// GetWikiStars returns the stars of a wiki
+func (c *Client) GetWikiStars(wikiID int) ([]*Star, error) {
+	return c.GetWikiStarsContext(context.Background(), wikiID)
+}
+
+// GetWikiStarsContext returns the stars of a wiki with context
+func (c *Client) GetWikiStarsContext(ctx context.Context, wikiID int, callOpts ...CallOption) ([]*Star, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/wikis/%v/stars", wikiID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stars := []*Star{}
+	if err := c.Do(ctx, req, &stars); err != nil {
+		return nil, err
+	}
+	return stars, nil
+}
+
+// GetWikiSharedFiles gets shared files of a wiki
+func (c *Client) GetWikiSharedFiles(wikiID int) ([]*SharedFile, error) {
+	return c.GetWikiSharedFilesContext(context.Background(), wikiID)
+}
+
+// GetWikiSharedFilesContext gets shared files of a wiki with context
+func (c *Client) GetWikiSharedFilesContext(ctx context.Context, wikiID int, callOpts ...CallOption) ([]*SharedFile, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/wikis/%v/sharedFiles", wikiID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedFiles := []*SharedFile{}
+	if err := c.Do(ctx, req, &sharedFiles); err != nil {
+		return nil, err
+	}
+	return sharedFiles, nil
+}
+
+// CreateWikiSharedFiles link shared files to a wiki
+func (c *Client) CreateWikiSharedFiles(wikiID int, input *CreateWikiSharedFilesInput) ([]*SharedFile, error) {
+	return c.CreateWikiSharedFilesContext(context.Background(), wikiID, input)
+}
+
+// CreateWikiSharedFilesContext link shared files to a wiki with context
+func (c *Client) CreateWikiSharedFilesContext(ctx context.Context, wikiID int, input *CreateWikiSharedFilesInput, callOpts ...CallOption) ([]*SharedFile, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/wikis/%v/sharedFiles", wikiID)
+
+	req, err := c.NewRequest("POST", u, input)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedFiles := []*SharedFile{}
+	if err := c.Do(ctx, req, &sharedFiles); err != nil {
+		return nil, err
+	}
+	return sharedFiles, nil
+}
+
+// DeleteWikiSharedFile unlink a shared file from a wiki
+func (c *Client) DeleteWikiSharedFile(wikiID, sharedFileID int) (*SharedFile, error) {
+	return c.DeleteWikiSharedFileContext(context.Background(), wikiID, sharedFileID)
+}
+
+// DeleteWikiSharedFileContext unlink a shared file from a wiki with context
+func (c *Client) DeleteWikiSharedFileContext(ctx context.Context, wikiID, sharedFileID int, callOpts ...CallOption) (*SharedFile, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/wikis/%v/sharedFiles/%v", wikiID, sharedFileID)
+
+	req, err := c.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedFile := new(SharedFile)
+	if err := c.Do(ctx, req, &sharedFile); err != nil {
+		return nil, err
+	}
+	return sharedFile, nil
+}
+
 // GetMyRecentlyViewedWikisOptions specifies parameters to the GetMyRecentlyViewedWikis method.
 type GetMyRecentlyViewedWikisOptions struct {
 	Order  Order `url:"order,omitempty"`
@@ -377,3 +477,8 @@ type UpdateWikiInput struct {
 type AddAttachmentToWikiInput struct {
 	AttachmentIDs []int `json:"attachmentId"`
 }
+
+// CreateWikiSharedFilesInput specifies parameters to the CreateWikiSharedFiles method.
+type CreateWikiSharedFilesInput struct {
+	FileIDs []int `json:"fileId,omitempty"`
+}
@@ -0,0 +1,447 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxWikiHistoryCount is the maximum count of wiki history in a request
+const maxWikiHistoryCount = 100
+
+// wikiDiffContext is the number of unchanged lines around changes in a diff
+const wikiDiffContext = 3
+
+// WikiHistory : a revision of a wiki
+type WikiHistory struct {
+	PageID      *int       `json:"pageId,omitempty"`
+	Version     *int       `json:"version,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Content     *string    `json:"content,omitempty"`
+	CreatedUser *User      `json:"createdUser,omitempty"`
+	Created     *Timestamp `json:"created,omitempty"`
+}
+
+// WikiBlameLine : a line of a wiki and the revision which introduced it
+type WikiBlameLine struct {
+	Number  int
+	Text    string
+	Version int
+	User    *User
+	Created *Timestamp
+}
+
+// GetWikiHistory returns the history of a wiki
+func (c *Client) GetWikiHistory(wikiID int, opts *GetWikiHistoryOptions) ([]*WikiHistory, error) {
+	return c.GetWikiHistoryContext(context.Background(), wikiID, opts)
+}
+
+// GetWikiHistoryContext returns the history of a wiki with context
+func (c *Client) GetWikiHistoryContext(ctx context.Context, wikiID int, opts *GetWikiHistoryOptions, callOpts ...CallOption) ([]*WikiHistory, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	u := fmt.Sprintf("/api/v2/wikis/%v/history", wikiID)
+
+	u, err := c.AddOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	history := []*WikiHistory{}
+	if err := c.Do(ctx, req, &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// getAllWikiHistoryContext returns all the history of a wiki in ascending order of versions
+func (c *Client) getAllWikiHistoryContext(ctx context.Context, wikiID int) ([]*WikiHistory, error) {
+	all := []*WikiHistory{}
+	opts := &GetWikiHistoryOptions{Count: Int(maxWikiHistoryCount), Order: OrderAsc}
+	for {
+		history, err := c.GetWikiHistoryContext(ctx, wikiID, opts)
+		if err != nil {
+			return nil, err
+		}
+		added := 0
+		for _, h := range history {
+			if h.Version == nil {
+				continue
+			}
+			// skip the version of minId in case it is inclusive
+			if opts.MinID != nil && *h.Version <= *opts.MinID {
+				continue
+			}
+			all = append(all, h)
+			added++
+		}
+		if len(history) < maxWikiHistoryCount || added == 0 {
+			return all, nil
+		}
+		opts.MinID = all[len(all)-1].Version
+	}
+}
+
+// GetWikiDiff returns the unified diff of the contents between two versions of a wiki
+func (c *Client) GetWikiDiff(wikiID, fromVersion, toVersion int) (string, error) {
+	return c.GetWikiDiffContext(context.Background(), wikiID, fromVersion, toVersion)
+}
+
+// GetWikiDiffContext returns the unified diff of the contents between two versions of a wiki with context
+func (c *Client) GetWikiDiffContext(ctx context.Context, wikiID, fromVersion, toVersion int, callOpts ...CallOption) (string, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	history, err := c.getAllWikiHistoryContext(ctx, wikiID)
+	if err != nil {
+		return "", err
+	}
+
+	var from, to *WikiHistory
+	for _, h := range history {
+		if *h.Version == fromVersion {
+			from = h
+		}
+		if *h.Version == toVersion {
+			to = h
+		}
+	}
+	if from == nil {
+		return "", errors.Errorf("version %d of wiki %d is not found", fromVersion, wikiID)
+	}
+	if to == nil {
+		return "", errors.Errorf("version %d of wiki %d is not found", toVersion, wikiID)
+	}
+	return DiffWikiHistory(from, to), nil
+}
+
+// GetWikiBlame returns the lines of the current content of a wiki with the
+// revisions which introduced them
+func (c *Client) GetWikiBlame(wikiID int) ([]*WikiBlameLine, error) {
+	return c.GetWikiBlameContext(context.Background(), wikiID)
+}
+
+// GetWikiBlameContext returns the lines of the current content of a wiki with
+// the revisions which introduced them with context
+func (c *Client) GetWikiBlameContext(ctx context.Context, wikiID int, callOpts ...CallOption) ([]*WikiBlameLine, error) {
+	ctx = withCallOptions(ctx, callOpts)
+	history, err := c.getAllWikiHistoryContext(ctx, wikiID)
+	if err != nil {
+		return nil, err
+	}
+	wiki, err := c.GetWikiContext(ctx, wikiID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the current content is the revision following the history
+	// unless the history already has it
+	version := 1
+	if len(history) > 0 {
+		last := history[len(history)-1]
+		version = *last.Version + 1
+		if derefString(last.Content) == derefString(wiki.Content) {
+			return BlameWiki(history), nil
+		}
+	}
+	history = append(history, &WikiHistory{
+		PageID:      wiki.ID,
+		Version:     Int(version),
+		Name:        wiki.Name,
+		Content:     wiki.Content,
+		CreatedUser: wiki.UpdatedUser,
+		Created:     wiki.Updated,
+	})
+	return BlameWiki(history), nil
+}
+
+// DiffWikiHistory returns the unified diff of the contents between two revisions of a wiki.
+// It returns an empty string if the contents are the same.
+func DiffWikiHistory(from, to *WikiHistory) string {
+	a := splitLines(derefString(from.Content))
+	b := splitLines(derefString(to.Content))
+	ops := diffLines(a, b)
+
+	var sb strings.Builder
+	header := false
+	for start := 0; start < len(ops); {
+		// find the next change
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+		// extend the hunk while the next change is within the context
+		end := start
+		for i := start; i < len(ops); i++ {
+			if ops[i].kind != ' ' {
+				end = i + 1
+			} else if i-end >= 2*wikiDiffContext {
+				break
+			}
+		}
+		first := max(0, start-wikiDiffContext)
+		last := min(len(ops), end+wikiDiffContext)
+
+		if !header {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", wikiHistoryLabel(from), wikiHistoryLabel(to))
+			header = true
+		}
+		aCount, bCount := 0, 0
+		for _, op := range ops[first:last] {
+			if op.kind != '+' {
+				aCount++
+			}
+			if op.kind != '-' {
+				bCount++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", diffRange(ops[first].a, aCount), diffRange(ops[first].b, bCount))
+		for _, op := range ops[first:last] {
+			switch op.kind {
+			case '+':
+				fmt.Fprintf(&sb, "+%s\n", b[op.b])
+			default:
+				fmt.Fprintf(&sb, "%c%s\n", op.kind, a[op.a])
+			}
+		}
+		start = last
+	}
+	return sb.String()
+}
+
+// BlameWiki returns the lines of the latest revision of a wiki with the
+// revisions which introduced them. The history is sorted by version.
+func BlameWiki(history []*WikiHistory) []*WikiBlameLine {
+	history = append([]*WikiHistory(nil), history...)
+	sort.SliceStable(history, func(i, j int) bool {
+		return derefInt(history[i].Version) < derefInt(history[j].Version)
+	})
+
+	var lines []string
+	var blame []*WikiBlameLine
+	for _, h := range history {
+		next := splitLines(derefString(h.Content))
+		nextBlame := make([]*WikiBlameLine, len(next))
+		for _, op := range diffLines(lines, next) {
+			switch op.kind {
+			case ' ':
+				nextBlame[op.b] = blame[op.a]
+			case '+':
+				nextBlame[op.b] = &WikiBlameLine{
+					Text:    next[op.b],
+					Version: derefInt(h.Version),
+					User:    h.CreatedUser,
+					Created: h.Created,
+				}
+			}
+		}
+		lines, blame = next, nextBlame
+	}
+
+	for i, line := range blame {
+		l := *line
+		l.Number = i + 1
+		blame[i] = &l
+	}
+	return blame
+}
+
+// wikiHistoryLabel returns the name of a file in a diff
+func wikiHistoryLabel(h *WikiHistory) string {
+	return fmt.Sprintf("%s (version %d)", derefString(h.Name), derefInt(h.Version))
+}
+
+// diffRange returns a range of lines in a hunk header, where start is 0-based
+func diffRange(start, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
+
+func derefInt(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// splitLines splits text into lines without line breaks
+func splitLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffOp : an operation of a line diff.
+// kind is ' ' to keep a[a] as b[b], '-' to delete a[a] and '+' to insert b[b].
+// For the other kinds, a and b are the positions in a and b.
+type diffOp struct {
+	kind byte
+	a, b int
+}
+
+// diffLines returns the operations to edit a into b by the shortest edit script.
+// It takes O((N+M)D) time and O(N+M) space by Myers' algorithm in linear space,
+// where N and M are the numbers of lines and D is the number of edits.
+func diffLines(a, b []string) []diffOp {
+	ops := appendDiffOps(make([]diffOp, 0, len(a)+len(b)), a, b, 0, 0)
+
+	// put the deletions before the insertions in each change
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		j, deleted := i, 0
+		for ; j < len(ops) && ops[j].kind != ' '; j++ {
+			if ops[j].kind == '-' {
+				deleted++
+			}
+		}
+		a0, b0 := ops[i].a, ops[i].b
+		for k := i; k < j; k++ {
+			if k-i < deleted {
+				ops[k] = diffOp{'-', a0 + k - i, b0}
+			} else {
+				ops[k] = diffOp{'+', a0 + deleted, b0 + k - i - deleted}
+			}
+		}
+		i = j
+	}
+	return ops
+}
+
+// appendDiffOps appends the operations to edit a into b, where a and b start
+// at aOff and bOff of the whole lines. It divides the lines at the middle snake.
+func appendDiffOps(ops []diffOp, a, b []string, aOff, bOff int) []diffOp {
+	// skip the common prefix and suffix
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		ops = append(ops, diffOp{' ', aOff + prefix, bOff + prefix})
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	aOff, bOff = aOff+prefix, bOff+prefix
+
+	switch {
+	case len(ma) == 0:
+		for j := range mb {
+			ops = append(ops, diffOp{'+', aOff, bOff + j})
+		}
+	case len(mb) == 0:
+		for i := range ma {
+			ops = append(ops, diffOp{'-', aOff + i, bOff})
+		}
+	default:
+		x, y := middleSnake(ma, mb)
+		ops = appendDiffOps(ops, ma[:x], mb[:y], aOff, bOff)
+		ops = appendDiffOps(ops, ma[x:], mb[y:], aOff+x, bOff+y)
+	}
+
+	for k := suffix; k > 0; k-- {
+		ops = append(ops, diffOp{' ', aOff + len(ma) + suffix - k, bOff + len(mb) + suffix - k})
+	}
+	return ops
+}
+
+// middleSnake returns the point where the forward and the reverse searches
+// of the shortest edit script of a and b overlap. a and b must not be empty,
+// and must differ in the first and the last lines.
+func middleSnake(a, b []string) (int, int) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD + 1
+	// forward[offset+k] is the furthest x on the diagonal k from the start,
+	// and backward[offset+k] is the one from the end
+	forward := make([]int, 2*offset+1)
+	backward := make([]int, 2*offset+1)
+	for i := range forward {
+		forward[i], backward[i] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	delta := n - m
+	odd := delta%2 != 0
+	// the diagonals out of the grid are skipped
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			forward[offset+k] = x
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case odd:
+				if bk := offset + delta - k; bk >= 0 && bk < len(backward) && backward[bk] != -1 && x >= n-backward[bk] {
+					return x, y
+				}
+			}
+		}
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && backward[offset+k-1] < backward[offset+k+1]) {
+				x = backward[offset+k+1]
+			} else {
+				x = backward[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x++
+				y++
+			}
+			backward[offset+k] = x
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !odd:
+				if fk := offset + delta - k; fk >= 0 && fk < len(forward) && forward[fk] != -1 {
+					if fx := forward[fk]; fx >= n-x {
+						return fx, fx - (fk - offset)
+					}
+				}
+			}
+		}
+	}
+	// the searches always overlap, but replace all the lines just in case
+	return n, 0
+}
+
+// GetWikiHistoryOptions specifies parameters to the GetWikiHistory method.
+type GetWikiHistoryOptions struct {
+	MinID *int  `url:"minId,omitempty"`
+	MaxID *int  `url:"maxId,omitempty"`
+	Count *int  `url:"count,omitempty"`
+	Order Order `url:"order,omitempty"`
+}
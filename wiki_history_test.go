@@ -0,0 +1,194 @@
+package backlog
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWikiHistory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wikis/1/history", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "2", r.URL.Query().Get("minId"))
+		if _, err := fmt.Fprint(w, `[{
+			"pageId": 1,
+			"version": 3,
+			"name": "Home",
+			"content": "hello",
+			"createdUser": {"id": 1, "name": "eguchi"},
+			"created": "2006-01-02T15:04:05Z"
+		}]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	history, err := client.GetWikiHistory(1, &GetWikiHistoryOptions{MinID: Int(2)})
+	assert.NoError(t, err)
+	assert.Equal(t, []*WikiHistory{{
+		PageID:      Int(1),
+		Version:     Int(3),
+		Name:        String("Home"),
+		Content:     String("hello"),
+		CreatedUser: &User{ID: Int(1), Name: String("eguchi")},
+		Created:     &Timestamp{referenceTime},
+	}}, history)
+}
+
+func TestDiffWikiHistory(t *testing.T) {
+	lines := func(n int, changes map[int]string) string {
+		var sb strings.Builder
+		for i := 1; i <= n; i++ {
+			if s, ok := changes[i]; ok {
+				sb.WriteString(s + "\n")
+			} else {
+				fmt.Fprintf(&sb, "l%d\n", i)
+			}
+		}
+		return sb.String()
+	}
+	from := &WikiHistory{Name: String("Home"), Version: Int(1), Content: String(lines(20, nil))}
+	to := &WikiHistory{Name: String("Home"), Version: Int(2), Content: String(lines(20, map[int]string{2: "X", 19: "Y"}))}
+
+	assert.Equal(t, `--- Home (version 1)
++++ Home (version 2)
+@@ -1,5 +1,5 @@
+ l1
+-l2
++X
+ l3
+ l4
+ l5
+@@ -16,5 +16,5 @@
+ l16
+ l17
+ l18
+-l19
++Y
+ l20
+`, DiffWikiHistory(from, to))
+
+	assert.Equal(t, "", DiffWikiHistory(from, from))
+
+	empty := &WikiHistory{Name: String("Home"), Version: Int(0)}
+	assert.Equal(t, `--- Home (version 0)
++++ Home (version 1)
+@@ -0,0 +1,2 @@
++a
++b
+`, DiffWikiHistory(empty, &WikiHistory{Name: String("Home"), Version: Int(1), Content: String("a\r\nb")}))
+}
+
+func setupWikiHistory(t *testing.T, mux *http.ServeMux) {
+	t.Helper()
+	mux.HandleFunc("/wikis/1/history", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "asc", r.URL.Query().Get("order"))
+		if _, err := fmt.Fprint(w, `[
+			{"version": 1, "name": "Home", "content": "a\nb\n", "createdUser": {"id": 1}, "created": "2006-01-02T15:04:05Z"},
+			{"version": 2, "name": "Home", "content": "a\nB\nc", "createdUser": {"id": 2}, "created": "2006-01-02T15:04:05Z"}
+		]`); err != nil {
+			t.Fatal(err)
+		}
+	})
+	mux.HandleFunc("/wikis/1", func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := fmt.Fprint(w, `{"id": 1, "name": "Home", "content": "a\nB\nc\nd", "updatedUser": {"id": 3}}`); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestGetWikiDiff(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupWikiHistory(t, mux)
+
+	diff, err := client.GetWikiDiff(1, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, `--- Home (version 1)
++++ Home (version 2)
+@@ -1,2 +1,3 @@
+ a
+-b
++B
++c
+`, diff)
+
+	_, err = client.GetWikiDiff(1, 1, 3)
+	assert.Error(t, err)
+}
+
+func TestGetWikiDiff_SameVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupWikiHistory(t, mux)
+
+	diff, err := client.GetWikiDiff(1, 2, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestGetWikiBlame(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupWikiHistory(t, mux)
+
+	blame, err := client.GetWikiBlame(1)
+	assert.NoError(t, err)
+	var got []string
+	for _, line := range blame {
+		got = append(got, fmt.Sprintf("%d %s v%d u%d", line.Number, line.Text, line.Version, *line.User.ID))
+	}
+	assert.Equal(t, []string{"1 a v1 u1", "2 B v2 u2", "3 c v2 u2", "4 d v3 u3"}, got)
+}
+
+func TestBlameWikiLargePage(t *testing.T) {
+	const n = 50000
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("l%d", i+1)
+	}
+	v1 := strings.Join(lines, "\n")
+	lines[9999], lines[39999] = "X", "Y"
+	v2 := strings.Join(lines, "\n")
+	v3 := strings.Join(append(lines[1:], "Z"), "\n")
+	history := []*WikiHistory{
+		{Name: String("Home"), Version: Int(1), Content: String(v1)},
+		{Name: String("Home"), Version: Int(2), Content: String(v2)},
+		{Name: String("Home"), Version: Int(3), Content: String(v3)},
+	}
+
+	blame := BlameWiki(history)
+	if assert.Len(t, blame, n) {
+		assert.Equal(t, WikiBlameLine{Number: 1, Text: "l2", Version: 1}, *blame[0])
+		assert.Equal(t, WikiBlameLine{Number: 9999, Text: "X", Version: 2}, *blame[9998])
+		assert.Equal(t, WikiBlameLine{Number: 39999, Text: "Y", Version: 2}, *blame[39998])
+		assert.Equal(t, WikiBlameLine{Number: n, Text: "Z", Version: 3}, *blame[n-1])
+	}
+
+	assert.Equal(t, `--- Home (version 1)
++++ Home (version 2)
+@@ -9997,7 +9997,7 @@
+ l9997
+ l9998
+ l9999
+-l10000
++X
+ l10001
+ l10002
+ l10003
+@@ -39997,7 +39997,7 @@
+ l39997
+ l39998
+ l39999
+-l40000
++Y
+ l40001
+ l40002
+ l40003
+`, DiffWikiHistory(history[0], history[1]))
+}
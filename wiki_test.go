@@ -719,3 +719,138 @@ func TestGetWiki_NewRequestError(t *testing.T) {
 
 	client.baseURL = originalBaseURL
 }
+
+func TestGetWikiStars(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wikis/1/stars", func(w http.ResponseWriter, _ *http.Request) {
+		j := fmt.Sprintf("[%s]", testJSONStar)
+		if _, err := fmt.Fprint(w, j); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	stars, err := client.GetWikiStars(1)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*Star{getTestUserStarsWitID(1)}
+	if !reflect.DeepEqual(want, stars) {
+		t.Fatal(ErrIncorrectResponse)
+	}
+}
+
+func TestGetWikiStarsFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wikis/1/stars", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := client.GetWikiStars(1); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestGetWikiSharedFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wikis/1/sharedFiles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		j := fmt.Sprintf(`[%s]`, testJSONSharedFile)
+		if _, err := fmt.Fprint(w, j); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.GetWikiSharedFiles(1)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*SharedFile{getTestSharedFile()}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse)
+	}
+}
+
+func TestCreateWikiSharedFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wikis/1/sharedFiles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		j := fmt.Sprintf(`[%s]`, testJSONSharedFile)
+		if _, err := fmt.Fprint(w, j); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.CreateWikiSharedFiles(1, &CreateWikiSharedFilesInput{
+		FileIDs: []int{454403},
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := []*SharedFile{getTestSharedFile()}
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse)
+	}
+}
+
+func TestCreateWikiSharedFilesFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wikis/1/sharedFiles", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := client.CreateWikiSharedFiles(1, &CreateWikiSharedFilesInput{FileIDs: []int{454403}}); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestDeleteWikiSharedFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wikis/1/sharedFiles/454403", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if _, err := fmt.Fprint(w, testJSONSharedFile); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected, err := client.DeleteWikiSharedFile(1, 454403)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	want := getTestSharedFile()
+	if !reflect.DeepEqual(want, expected) {
+		t.Fatal(ErrIncorrectResponse)
+	}
+}
+
+func TestDeleteWikiSharedFileFailed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wikis/1/sharedFiles/454403", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := client.DeleteWikiSharedFile(1, 454403); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}